@@ -22,6 +22,19 @@ type CronWorkflowServiceClient interface {
 	UpdateCronWorkflow(ctx context.Context, in *UpdateCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflow, error)
 	GetCronWorkflow(ctx context.Context, in *GetCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflow, error)
 	ListCronWorkflows(ctx context.Context, in *ListCronWorkflowRequest, opts ...grpc.CallOption) (*ListCronWorkflowsResponse, error)
+	// List the workflow executions started by the cron workflow, newest first
+	ListCronWorkflowRuns(ctx context.Context, in *ListCronWorkflowRunsRequest, opts ...grpc.CallOption) (*ListCronWorkflowRunsResponse, error)
+	// Stop the cron workflow from running on its schedule until it is resumed
+	SuspendCronWorkflow(ctx context.Context, in *SuspendCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflow, error)
+	// Run a suspended cron workflow on its schedule again
+	ResumeCronWorkflow(ctx context.Context, in *ResumeCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflow, error)
+	// Start a run of the cron workflow now, outside of its schedule
+	RunCronWorkflowNow(ctx context.Context, in *RunCronWorkflowNowRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
+	// Run the cron workflow for each time it was scheduled to run in a past range, as a backfill
+	BackfillCronWorkflow(ctx context.Context, in *BackfillCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflowBackfill, error)
+	ListCronWorkflowBackfills(ctx context.Context, in *ListCronWorkflowBackfillsRequest, opts ...grpc.CallOption) (*ListCronWorkflowBackfillsResponse, error)
+	// Stop launching the runs of a backfill and terminate the ones that are running
+	CancelCronWorkflowBackfill(ctx context.Context, in *CancelCronWorkflowBackfillRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteCronWorkflow(ctx context.Context, in *DeleteCronWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -69,6 +82,69 @@ func (c *cronWorkflowServiceClient) ListCronWorkflows(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *cronWorkflowServiceClient) ListCronWorkflowRuns(ctx context.Context, in *ListCronWorkflowRunsRequest, opts ...grpc.CallOption) (*ListCronWorkflowRunsResponse, error) {
+	out := new(ListCronWorkflowRunsResponse)
+	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/ListCronWorkflowRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronWorkflowServiceClient) SuspendCronWorkflow(ctx context.Context, in *SuspendCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflow, error) {
+	out := new(CronWorkflow)
+	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/SuspendCronWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronWorkflowServiceClient) ResumeCronWorkflow(ctx context.Context, in *ResumeCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflow, error) {
+	out := new(CronWorkflow)
+	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/ResumeCronWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronWorkflowServiceClient) RunCronWorkflowNow(ctx context.Context, in *RunCronWorkflowNowRequest, opts ...grpc.CallOption) (*WorkflowExecution, error) {
+	out := new(WorkflowExecution)
+	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/RunCronWorkflowNow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, in *BackfillCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflowBackfill, error) {
+	out := new(CronWorkflowBackfill)
+	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/BackfillCronWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronWorkflowServiceClient) ListCronWorkflowBackfills(ctx context.Context, in *ListCronWorkflowBackfillsRequest, opts ...grpc.CallOption) (*ListCronWorkflowBackfillsResponse, error) {
+	out := new(ListCronWorkflowBackfillsResponse)
+	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/ListCronWorkflowBackfills", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cronWorkflowServiceClient) CancelCronWorkflowBackfill(ctx context.Context, in *CancelCronWorkflowBackfillRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/CancelCronWorkflowBackfill", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cronWorkflowServiceClient) DeleteCronWorkflow(ctx context.Context, in *DeleteCronWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/DeleteCronWorkflow", in, out, opts...)
@@ -86,6 +162,19 @@ type CronWorkflowServiceServer interface {
 	UpdateCronWorkflow(context.Context, *UpdateCronWorkflowRequest) (*CronWorkflow, error)
 	GetCronWorkflow(context.Context, *GetCronWorkflowRequest) (*CronWorkflow, error)
 	ListCronWorkflows(context.Context, *ListCronWorkflowRequest) (*ListCronWorkflowsResponse, error)
+	// List the workflow executions started by the cron workflow, newest first
+	ListCronWorkflowRuns(context.Context, *ListCronWorkflowRunsRequest) (*ListCronWorkflowRunsResponse, error)
+	// Stop the cron workflow from running on its schedule until it is resumed
+	SuspendCronWorkflow(context.Context, *SuspendCronWorkflowRequest) (*CronWorkflow, error)
+	// Run a suspended cron workflow on its schedule again
+	ResumeCronWorkflow(context.Context, *ResumeCronWorkflowRequest) (*CronWorkflow, error)
+	// Start a run of the cron workflow now, outside of its schedule
+	RunCronWorkflowNow(context.Context, *RunCronWorkflowNowRequest) (*WorkflowExecution, error)
+	// Run the cron workflow for each time it was scheduled to run in a past range, as a backfill
+	BackfillCronWorkflow(context.Context, *BackfillCronWorkflowRequest) (*CronWorkflowBackfill, error)
+	ListCronWorkflowBackfills(context.Context, *ListCronWorkflowBackfillsRequest) (*ListCronWorkflowBackfillsResponse, error)
+	// Stop launching the runs of a backfill and terminate the ones that are running
+	CancelCronWorkflowBackfill(context.Context, *CancelCronWorkflowBackfillRequest) (*emptypb.Empty, error)
 	DeleteCronWorkflow(context.Context, *DeleteCronWorkflowRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedCronWorkflowServiceServer()
 }
@@ -106,6 +195,27 @@ func (UnimplementedCronWorkflowServiceServer) GetCronWorkflow(context.Context, *
 func (UnimplementedCronWorkflowServiceServer) ListCronWorkflows(context.Context, *ListCronWorkflowRequest) (*ListCronWorkflowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCronWorkflows not implemented")
 }
+func (UnimplementedCronWorkflowServiceServer) ListCronWorkflowRuns(context.Context, *ListCronWorkflowRunsRequest) (*ListCronWorkflowRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCronWorkflowRuns not implemented")
+}
+func (UnimplementedCronWorkflowServiceServer) SuspendCronWorkflow(context.Context, *SuspendCronWorkflowRequest) (*CronWorkflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendCronWorkflow not implemented")
+}
+func (UnimplementedCronWorkflowServiceServer) ResumeCronWorkflow(context.Context, *ResumeCronWorkflowRequest) (*CronWorkflow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCronWorkflow not implemented")
+}
+func (UnimplementedCronWorkflowServiceServer) RunCronWorkflowNow(context.Context, *RunCronWorkflowNowRequest) (*WorkflowExecution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunCronWorkflowNow not implemented")
+}
+func (UnimplementedCronWorkflowServiceServer) BackfillCronWorkflow(context.Context, *BackfillCronWorkflowRequest) (*CronWorkflowBackfill, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillCronWorkflow not implemented")
+}
+func (UnimplementedCronWorkflowServiceServer) ListCronWorkflowBackfills(context.Context, *ListCronWorkflowBackfillsRequest) (*ListCronWorkflowBackfillsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCronWorkflowBackfills not implemented")
+}
+func (UnimplementedCronWorkflowServiceServer) CancelCronWorkflowBackfill(context.Context, *CancelCronWorkflowBackfillRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCronWorkflowBackfill not implemented")
+}
 func (UnimplementedCronWorkflowServiceServer) DeleteCronWorkflow(context.Context, *DeleteCronWorkflowRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronWorkflow not implemented")
 }
@@ -194,6 +304,132 @@ func _CronWorkflowService_ListCronWorkflows_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CronWorkflowService_ListCronWorkflowRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCronWorkflowRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).ListCronWorkflowRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CronWorkflowService/ListCronWorkflowRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).ListCronWorkflowRuns(ctx, req.(*ListCronWorkflowRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CronWorkflowService_SuspendCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendCronWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).SuspendCronWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CronWorkflowService/SuspendCronWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).SuspendCronWorkflow(ctx, req.(*SuspendCronWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CronWorkflowService_ResumeCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCronWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).ResumeCronWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CronWorkflowService/ResumeCronWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).ResumeCronWorkflow(ctx, req.(*ResumeCronWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CronWorkflowService_RunCronWorkflowNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunCronWorkflowNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).RunCronWorkflowNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CronWorkflowService/RunCronWorkflowNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).RunCronWorkflowNow(ctx, req.(*RunCronWorkflowNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CronWorkflowService_BackfillCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillCronWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).BackfillCronWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CronWorkflowService/BackfillCronWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).BackfillCronWorkflow(ctx, req.(*BackfillCronWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CronWorkflowService_ListCronWorkflowBackfills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCronWorkflowBackfillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).ListCronWorkflowBackfills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CronWorkflowService/ListCronWorkflowBackfills",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).ListCronWorkflowBackfills(ctx, req.(*ListCronWorkflowBackfillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CronWorkflowService_CancelCronWorkflowBackfill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCronWorkflowBackfillRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).CancelCronWorkflowBackfill(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CronWorkflowService/CancelCronWorkflowBackfill",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).CancelCronWorkflowBackfill(ctx, req.(*CancelCronWorkflowBackfillRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CronWorkflowService_DeleteCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCronWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -232,6 +468,34 @@ var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListCronWorkflows",
 			Handler:    _CronWorkflowService_ListCronWorkflows_Handler,
 		},
+		{
+			MethodName: "ListCronWorkflowRuns",
+			Handler:    _CronWorkflowService_ListCronWorkflowRuns_Handler,
+		},
+		{
+			MethodName: "SuspendCronWorkflow",
+			Handler:    _CronWorkflowService_SuspendCronWorkflow_Handler,
+		},
+		{
+			MethodName: "ResumeCronWorkflow",
+			Handler:    _CronWorkflowService_ResumeCronWorkflow_Handler,
+		},
+		{
+			MethodName: "RunCronWorkflowNow",
+			Handler:    _CronWorkflowService_RunCronWorkflowNow_Handler,
+		},
+		{
+			MethodName: "BackfillCronWorkflow",
+			Handler:    _CronWorkflowService_BackfillCronWorkflow_Handler,
+		},
+		{
+			MethodName: "ListCronWorkflowBackfills",
+			Handler:    _CronWorkflowService_ListCronWorkflowBackfills_Handler,
+		},
+		{
+			MethodName: "CancelCronWorkflowBackfill",
+			Handler:    _CronWorkflowService_CancelCronWorkflowBackfill_Handler,
+		},
 		{
 			MethodName: "DeleteCronWorkflow",
 			Handler:    _CronWorkflowService_DeleteCronWorkflow_Handler,
@@ -4,6 +4,7 @@ package gen
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -27,14 +28,23 @@ type WorkflowServiceClient interface {
 	ListWorkflowExecutions(ctx context.Context, in *ListWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionsResponse, error)
 	WatchWorkflowExecution(ctx context.Context, in *WatchWorkflowExecutionRequest, opts ...grpc.CallOption) (WorkflowService_WatchWorkflowExecutionClient, error)
 	GetWorkflowExecutionLogs(ctx context.Context, in *GetWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (WorkflowService_GetWorkflowExecutionLogsClient, error)
+	SearchWorkflowExecutionLogs(ctx context.Context, in *SearchWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (*SearchWorkflowExecutionLogsResponse, error)
+	DownloadWorkflowExecutionLogs(ctx context.Context, in *DownloadWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	GetWorkflowExecutionMetrics(ctx context.Context, in *GetWorkflowExecutionMetricsRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionMetricsResponse, error)
+	GetWorkflowExecutionGraph(ctx context.Context, in *GetWorkflowExecutionGraphRequest, opts ...grpc.CallOption) (*WorkflowExecutionGraph, error)
+	CompareWorkflowExecutions(ctx context.Context, in *CompareWorkflowExecutionsRequest, opts ...grpc.CallOption) (*CompareWorkflowExecutionsResponse, error)
 	ResubmitWorkflowExecution(ctx context.Context, in *ResubmitWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
+	RetryWorkflowExecution(ctx context.Context, in *RetryWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
+	SuspendWorkflowExecution(ctx context.Context, in *SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResumeWorkflowExecution(ctx context.Context, in *ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
 	TerminateWorkflowExecution(ctx context.Context, in *TerminateWorkflowExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddWorkflowExecutionStatistics(ctx context.Context, in *AddWorkflowExecutionStatisticRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CronStartWorkflowExecutionStatistic(ctx context.Context, in *CronStartWorkflowExecutionStatisticRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateWorkflowExecutionStatus(ctx context.Context, in *UpdateWorkflowExecutionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AddWorkflowExecutionMetrics(ctx context.Context, in *AddWorkflowExecutionsMetricsRequest, opts ...grpc.CallOption) (*WorkflowExecutionsMetricsResponse, error)
 	UpdateWorkflowExecutionMetrics(ctx context.Context, in *UpdateWorkflowExecutionsMetricsRequest, opts ...grpc.CallOption) (*WorkflowExecutionsMetricsResponse, error)
+	AppendWorkflowExecutionMetricPoints(ctx context.Context, in *AppendWorkflowExecutionMetricPointsRequest, opts ...grpc.CallOption) (*WorkflowExecutionsMetricsResponse, error)
+	ListWorkflowExecutionMetricSeries(ctx context.Context, in *ListWorkflowExecutionMetricSeriesRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionMetricSeriesResponse, error)
 	ListWorkflowExecutionsField(ctx context.Context, in *ListWorkflowExecutionsFieldRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionsFieldResponse, error)
 }
 
@@ -155,6 +165,24 @@ func (x *workflowServiceGetWorkflowExecutionLogsClient) Recv() (*LogStreamRespon
 	return m, nil
 }
 
+func (c *workflowServiceClient) SearchWorkflowExecutionLogs(ctx context.Context, in *SearchWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (*SearchWorkflowExecutionLogsResponse, error) {
+	out := new(SearchWorkflowExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/SearchWorkflowExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) DownloadWorkflowExecutionLogs(ctx context.Context, in *DownloadWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/DownloadWorkflowExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) GetWorkflowExecutionMetrics(ctx context.Context, in *GetWorkflowExecutionMetricsRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionMetricsResponse, error) {
 	out := new(GetWorkflowExecutionMetricsResponse)
 	err := c.cc.Invoke(ctx, "/api.WorkflowService/GetWorkflowExecutionMetrics", in, out, opts...)
@@ -164,6 +192,24 @@ func (c *workflowServiceClient) GetWorkflowExecutionMetrics(ctx context.Context,
 	return out, nil
 }
 
+func (c *workflowServiceClient) GetWorkflowExecutionGraph(ctx context.Context, in *GetWorkflowExecutionGraphRequest, opts ...grpc.CallOption) (*WorkflowExecutionGraph, error) {
+	out := new(WorkflowExecutionGraph)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/GetWorkflowExecutionGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) CompareWorkflowExecutions(ctx context.Context, in *CompareWorkflowExecutionsRequest, opts ...grpc.CallOption) (*CompareWorkflowExecutionsResponse, error) {
+	out := new(CompareWorkflowExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/CompareWorkflowExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) ResubmitWorkflowExecution(ctx context.Context, in *ResubmitWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error) {
 	out := new(WorkflowExecution)
 	err := c.cc.Invoke(ctx, "/api.WorkflowService/ResubmitWorkflowExecution", in, out, opts...)
@@ -173,6 +219,33 @@ func (c *workflowServiceClient) ResubmitWorkflowExecution(ctx context.Context, i
 	return out, nil
 }
 
+func (c *workflowServiceClient) RetryWorkflowExecution(ctx context.Context, in *RetryWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error) {
+	out := new(WorkflowExecution)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/RetryWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) SuspendWorkflowExecution(ctx context.Context, in *SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/SuspendWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ResumeWorkflowExecution(ctx context.Context, in *ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error) {
+	out := new(WorkflowExecution)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/ResumeWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) TerminateWorkflowExecution(ctx context.Context, in *TerminateWorkflowExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/api.WorkflowService/TerminateWorkflowExecution", in, out, opts...)
@@ -227,6 +300,24 @@ func (c *workflowServiceClient) UpdateWorkflowExecutionMetrics(ctx context.Conte
 	return out, nil
 }
 
+func (c *workflowServiceClient) AppendWorkflowExecutionMetricPoints(ctx context.Context, in *AppendWorkflowExecutionMetricPointsRequest, opts ...grpc.CallOption) (*WorkflowExecutionsMetricsResponse, error) {
+	out := new(WorkflowExecutionsMetricsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/AppendWorkflowExecutionMetricPoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ListWorkflowExecutionMetricSeries(ctx context.Context, in *ListWorkflowExecutionMetricSeriesRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionMetricSeriesResponse, error) {
+	out := new(ListWorkflowExecutionMetricSeriesResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/ListWorkflowExecutionMetricSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) ListWorkflowExecutionsField(ctx context.Context, in *ListWorkflowExecutionsFieldRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionsFieldResponse, error) {
 	out := new(ListWorkflowExecutionsFieldResponse)
 	err := c.cc.Invoke(ctx, "/api.WorkflowService/ListWorkflowExecutionsField", in, out, opts...)
@@ -249,14 +340,23 @@ type WorkflowServiceServer interface {
 	ListWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 	WatchWorkflowExecution(*WatchWorkflowExecutionRequest, WorkflowService_WatchWorkflowExecutionServer) error
 	GetWorkflowExecutionLogs(*GetWorkflowExecutionLogsRequest, WorkflowService_GetWorkflowExecutionLogsServer) error
+	SearchWorkflowExecutionLogs(context.Context, *SearchWorkflowExecutionLogsRequest) (*SearchWorkflowExecutionLogsResponse, error)
+	DownloadWorkflowExecutionLogs(context.Context, *DownloadWorkflowExecutionLogsRequest) (*httpbody.HttpBody, error)
 	GetWorkflowExecutionMetrics(context.Context, *GetWorkflowExecutionMetricsRequest) (*GetWorkflowExecutionMetricsResponse, error)
+	GetWorkflowExecutionGraph(context.Context, *GetWorkflowExecutionGraphRequest) (*WorkflowExecutionGraph, error)
+	CompareWorkflowExecutions(context.Context, *CompareWorkflowExecutionsRequest) (*CompareWorkflowExecutionsResponse, error)
 	ResubmitWorkflowExecution(context.Context, *ResubmitWorkflowExecutionRequest) (*WorkflowExecution, error)
+	RetryWorkflowExecution(context.Context, *RetryWorkflowExecutionRequest) (*WorkflowExecution, error)
+	SuspendWorkflowExecution(context.Context, *SuspendWorkflowExecutionRequest) (*emptypb.Empty, error)
+	ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*WorkflowExecution, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*emptypb.Empty, error)
 	AddWorkflowExecutionStatistics(context.Context, *AddWorkflowExecutionStatisticRequest) (*emptypb.Empty, error)
 	CronStartWorkflowExecutionStatistic(context.Context, *CronStartWorkflowExecutionStatisticRequest) (*emptypb.Empty, error)
 	UpdateWorkflowExecutionStatus(context.Context, *UpdateWorkflowExecutionStatusRequest) (*emptypb.Empty, error)
 	AddWorkflowExecutionMetrics(context.Context, *AddWorkflowExecutionsMetricsRequest) (*WorkflowExecutionsMetricsResponse, error)
 	UpdateWorkflowExecutionMetrics(context.Context, *UpdateWorkflowExecutionsMetricsRequest) (*WorkflowExecutionsMetricsResponse, error)
+	AppendWorkflowExecutionMetricPoints(context.Context, *AppendWorkflowExecutionMetricPointsRequest) (*WorkflowExecutionsMetricsResponse, error)
+	ListWorkflowExecutionMetricSeries(context.Context, *ListWorkflowExecutionMetricSeriesRequest) (*ListWorkflowExecutionMetricSeriesResponse, error)
 	ListWorkflowExecutionsField(context.Context, *ListWorkflowExecutionsFieldRequest) (*ListWorkflowExecutionsFieldResponse, error)
 	mustEmbedUnimplementedWorkflowServiceServer()
 }
@@ -286,12 +386,33 @@ func (UnimplementedWorkflowServiceServer) WatchWorkflowExecution(*WatchWorkflowE
 func (UnimplementedWorkflowServiceServer) GetWorkflowExecutionLogs(*GetWorkflowExecutionLogsRequest, WorkflowService_GetWorkflowExecutionLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionLogs not implemented")
 }
+func (UnimplementedWorkflowServiceServer) SearchWorkflowExecutionLogs(context.Context, *SearchWorkflowExecutionLogsRequest) (*SearchWorkflowExecutionLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchWorkflowExecutionLogs not implemented")
+}
+func (UnimplementedWorkflowServiceServer) DownloadWorkflowExecutionLogs(context.Context, *DownloadWorkflowExecutionLogsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadWorkflowExecutionLogs not implemented")
+}
 func (UnimplementedWorkflowServiceServer) GetWorkflowExecutionMetrics(context.Context, *GetWorkflowExecutionMetricsRequest) (*GetWorkflowExecutionMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionMetrics not implemented")
 }
+func (UnimplementedWorkflowServiceServer) GetWorkflowExecutionGraph(context.Context, *GetWorkflowExecutionGraphRequest) (*WorkflowExecutionGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionGraph not implemented")
+}
+func (UnimplementedWorkflowServiceServer) CompareWorkflowExecutions(context.Context, *CompareWorkflowExecutionsRequest) (*CompareWorkflowExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareWorkflowExecutions not implemented")
+}
 func (UnimplementedWorkflowServiceServer) ResubmitWorkflowExecution(context.Context, *ResubmitWorkflowExecutionRequest) (*WorkflowExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResubmitWorkflowExecution not implemented")
 }
+func (UnimplementedWorkflowServiceServer) RetryWorkflowExecution(context.Context, *RetryWorkflowExecutionRequest) (*WorkflowExecution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWorkflowExecution not implemented")
+}
+func (UnimplementedWorkflowServiceServer) SuspendWorkflowExecution(context.Context, *SuspendWorkflowExecutionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendWorkflowExecution not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*WorkflowExecution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflowExecution not implemented")
+}
 func (UnimplementedWorkflowServiceServer) TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateWorkflowExecution not implemented")
 }
@@ -310,6 +431,12 @@ func (UnimplementedWorkflowServiceServer) AddWorkflowExecutionMetrics(context.Co
 func (UnimplementedWorkflowServiceServer) UpdateWorkflowExecutionMetrics(context.Context, *UpdateWorkflowExecutionsMetricsRequest) (*WorkflowExecutionsMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecutionMetrics not implemented")
 }
+func (UnimplementedWorkflowServiceServer) AppendWorkflowExecutionMetricPoints(context.Context, *AppendWorkflowExecutionMetricPointsRequest) (*WorkflowExecutionsMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendWorkflowExecutionMetricPoints not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ListWorkflowExecutionMetricSeries(context.Context, *ListWorkflowExecutionMetricSeriesRequest) (*ListWorkflowExecutionMetricSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowExecutionMetricSeries not implemented")
+}
 func (UnimplementedWorkflowServiceServer) ListWorkflowExecutionsField(context.Context, *ListWorkflowExecutionsFieldRequest) (*ListWorkflowExecutionsFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowExecutionsField not implemented")
 }
@@ -458,6 +585,42 @@ func (x *workflowServiceGetWorkflowExecutionLogsServer) Send(m *LogStreamRespons
 	return x.ServerStream.SendMsg(m)
 }
 
+func _WorkflowService_SearchWorkflowExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchWorkflowExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SearchWorkflowExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/SearchWorkflowExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SearchWorkflowExecutionLogs(ctx, req.(*SearchWorkflowExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_DownloadWorkflowExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadWorkflowExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).DownloadWorkflowExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/DownloadWorkflowExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).DownloadWorkflowExecutionLogs(ctx, req.(*DownloadWorkflowExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_GetWorkflowExecutionMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWorkflowExecutionMetricsRequest)
 	if err := dec(in); err != nil {
@@ -476,6 +639,42 @@ func _WorkflowService_GetWorkflowExecutionMetrics_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_GetWorkflowExecutionGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflowExecutionGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/GetWorkflowExecutionGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflowExecutionGraph(ctx, req.(*GetWorkflowExecutionGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_CompareWorkflowExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareWorkflowExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).CompareWorkflowExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/CompareWorkflowExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).CompareWorkflowExecutions(ctx, req.(*CompareWorkflowExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_ResubmitWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResubmitWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -494,6 +693,60 @@ func _WorkflowService_ResubmitWorkflowExecution_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_RetryWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RetryWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/RetryWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RetryWorkflowExecution(ctx, req.(*RetryWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_SuspendWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SuspendWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/SuspendWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SuspendWorkflowExecution(ctx, req.(*SuspendWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ResumeWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResumeWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/ResumeWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResumeWorkflowExecution(ctx, req.(*ResumeWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_TerminateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TerminateWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -602,6 +855,42 @@ func _WorkflowService_UpdateWorkflowExecutionMetrics_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_AppendWorkflowExecutionMetricPoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendWorkflowExecutionMetricPointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).AppendWorkflowExecutionMetricPoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/AppendWorkflowExecutionMetricPoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).AppendWorkflowExecutionMetricPoints(ctx, req.(*AppendWorkflowExecutionMetricPointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ListWorkflowExecutionMetricSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowExecutionMetricSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ListWorkflowExecutionMetricSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/ListWorkflowExecutionMetricSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ListWorkflowExecutionMetricSeries(ctx, req.(*ListWorkflowExecutionMetricSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_ListWorkflowExecutionsField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListWorkflowExecutionsFieldRequest)
 	if err := dec(in); err != nil {
@@ -644,14 +933,42 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflowExecutions",
 			Handler:    _WorkflowService_ListWorkflowExecutions_Handler,
 		},
+		{
+			MethodName: "SearchWorkflowExecutionLogs",
+			Handler:    _WorkflowService_SearchWorkflowExecutionLogs_Handler,
+		},
+		{
+			MethodName: "DownloadWorkflowExecutionLogs",
+			Handler:    _WorkflowService_DownloadWorkflowExecutionLogs_Handler,
+		},
 		{
 			MethodName: "GetWorkflowExecutionMetrics",
 			Handler:    _WorkflowService_GetWorkflowExecutionMetrics_Handler,
 		},
+		{
+			MethodName: "GetWorkflowExecutionGraph",
+			Handler:    _WorkflowService_GetWorkflowExecutionGraph_Handler,
+		},
+		{
+			MethodName: "CompareWorkflowExecutions",
+			Handler:    _WorkflowService_CompareWorkflowExecutions_Handler,
+		},
 		{
 			MethodName: "ResubmitWorkflowExecution",
 			Handler:    _WorkflowService_ResubmitWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "RetryWorkflowExecution",
+			Handler:    _WorkflowService_RetryWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "SuspendWorkflowExecution",
+			Handler:    _WorkflowService_SuspendWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflowExecution",
+			Handler:    _WorkflowService_ResumeWorkflowExecution_Handler,
+		},
 		{
 			MethodName: "TerminateWorkflowExecution",
 			Handler:    _WorkflowService_TerminateWorkflowExecution_Handler,
@@ -676,6 +993,14 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorkflowExecutionMetrics",
 			Handler:    _WorkflowService_UpdateWorkflowExecutionMetrics_Handler,
 		},
+		{
+			MethodName: "AppendWorkflowExecutionMetricPoints",
+			Handler:    _WorkflowService_AppendWorkflowExecutionMetricPoints_Handler,
+		},
+		{
+			MethodName: "ListWorkflowExecutionMetricSeries",
+			Handler:    _WorkflowService_ListWorkflowExecutionMetricSeries_Handler,
+		},
 		{
 			MethodName: "ListWorkflowExecutionsField",
 			Handler:    _WorkflowService_ListWorkflowExecutionsField_Handler,
@@ -27,6 +27,14 @@ type WorkflowTemplateServiceClient interface {
 	CloneWorkflowTemplate(ctx context.Context, in *CloneWorkflowTemplateRequest, opts ...grpc.CallOption) (*WorkflowTemplate, error)
 	ArchiveWorkflowTemplate(ctx context.Context, in *ArchiveWorkflowTemplateRequest, opts ...grpc.CallOption) (*ArchiveWorkflowTemplateResponse, error)
 	ListWorkflowTemplatesField(ctx context.Context, in *ListWorkflowTemplatesFieldRequest, opts ...grpc.CallOption) (*ListWorkflowTemplatesFieldResponse, error)
+	// Make a previous version of a workflow template the latest one, or create a new version from it if clone is true
+	SetLatestWorkflowTemplateVersion(ctx context.Context, in *SetLatestWorkflowTemplateVersionRequest, opts ...grpc.CallOption) (*WorkflowTemplate, error)
+	// Compare two versions of a workflow template. A version of 0 is the latest version.
+	DiffWorkflowTemplateVersions(ctx context.Context, in *DiffWorkflowTemplateVersionsRequest, opts ...grpc.CallOption) (*TemplateVersionDiff, error)
+	// Export workflow templates, with all of their versions, as a yaml bundle
+	ExportWorkflowTemplates(ctx context.Context, in *ExportWorkflowTemplatesRequest, opts ...grpc.CallOption) (*ExportWorkflowTemplatesResponse, error)
+	// Import the workflow templates of a bundle created by ExportWorkflowTemplates
+	ImportWorkflowTemplates(ctx context.Context, in *ImportWorkflowTemplatesRequest, opts ...grpc.CallOption) (*ImportWorkflowTemplatesResponse, error)
 }
 
 type workflowTemplateServiceClient struct {
@@ -118,6 +126,42 @@ func (c *workflowTemplateServiceClient) ListWorkflowTemplatesField(ctx context.C
 	return out, nil
 }
 
+func (c *workflowTemplateServiceClient) SetLatestWorkflowTemplateVersion(ctx context.Context, in *SetLatestWorkflowTemplateVersionRequest, opts ...grpc.CallOption) (*WorkflowTemplate, error) {
+	out := new(WorkflowTemplate)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTemplateService/SetLatestWorkflowTemplateVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTemplateServiceClient) DiffWorkflowTemplateVersions(ctx context.Context, in *DiffWorkflowTemplateVersionsRequest, opts ...grpc.CallOption) (*TemplateVersionDiff, error) {
+	out := new(TemplateVersionDiff)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTemplateService/DiffWorkflowTemplateVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTemplateServiceClient) ExportWorkflowTemplates(ctx context.Context, in *ExportWorkflowTemplatesRequest, opts ...grpc.CallOption) (*ExportWorkflowTemplatesResponse, error) {
+	out := new(ExportWorkflowTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTemplateService/ExportWorkflowTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTemplateServiceClient) ImportWorkflowTemplates(ctx context.Context, in *ImportWorkflowTemplatesRequest, opts ...grpc.CallOption) (*ImportWorkflowTemplatesResponse, error) {
+	out := new(ImportWorkflowTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTemplateService/ImportWorkflowTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkflowTemplateServiceServer is the server API for WorkflowTemplateService service.
 // All implementations must embed UnimplementedWorkflowTemplateServiceServer
 // for forward compatibility
@@ -132,6 +176,14 @@ type WorkflowTemplateServiceServer interface {
 	CloneWorkflowTemplate(context.Context, *CloneWorkflowTemplateRequest) (*WorkflowTemplate, error)
 	ArchiveWorkflowTemplate(context.Context, *ArchiveWorkflowTemplateRequest) (*ArchiveWorkflowTemplateResponse, error)
 	ListWorkflowTemplatesField(context.Context, *ListWorkflowTemplatesFieldRequest) (*ListWorkflowTemplatesFieldResponse, error)
+	// Make a previous version of a workflow template the latest one, or create a new version from it if clone is true
+	SetLatestWorkflowTemplateVersion(context.Context, *SetLatestWorkflowTemplateVersionRequest) (*WorkflowTemplate, error)
+	// Compare two versions of a workflow template. A version of 0 is the latest version.
+	DiffWorkflowTemplateVersions(context.Context, *DiffWorkflowTemplateVersionsRequest) (*TemplateVersionDiff, error)
+	// Export workflow templates, with all of their versions, as a yaml bundle
+	ExportWorkflowTemplates(context.Context, *ExportWorkflowTemplatesRequest) (*ExportWorkflowTemplatesResponse, error)
+	// Import the workflow templates of a bundle created by ExportWorkflowTemplates
+	ImportWorkflowTemplates(context.Context, *ImportWorkflowTemplatesRequest) (*ImportWorkflowTemplatesResponse, error)
 	mustEmbedUnimplementedWorkflowTemplateServiceServer()
 }
 
@@ -166,6 +218,18 @@ func (UnimplementedWorkflowTemplateServiceServer) ArchiveWorkflowTemplate(contex
 func (UnimplementedWorkflowTemplateServiceServer) ListWorkflowTemplatesField(context.Context, *ListWorkflowTemplatesFieldRequest) (*ListWorkflowTemplatesFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowTemplatesField not implemented")
 }
+func (UnimplementedWorkflowTemplateServiceServer) SetLatestWorkflowTemplateVersion(context.Context, *SetLatestWorkflowTemplateVersionRequest) (*WorkflowTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLatestWorkflowTemplateVersion not implemented")
+}
+func (UnimplementedWorkflowTemplateServiceServer) DiffWorkflowTemplateVersions(context.Context, *DiffWorkflowTemplateVersionsRequest) (*TemplateVersionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffWorkflowTemplateVersions not implemented")
+}
+func (UnimplementedWorkflowTemplateServiceServer) ExportWorkflowTemplates(context.Context, *ExportWorkflowTemplatesRequest) (*ExportWorkflowTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportWorkflowTemplates not implemented")
+}
+func (UnimplementedWorkflowTemplateServiceServer) ImportWorkflowTemplates(context.Context, *ImportWorkflowTemplatesRequest) (*ImportWorkflowTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportWorkflowTemplates not implemented")
+}
 func (UnimplementedWorkflowTemplateServiceServer) mustEmbedUnimplementedWorkflowTemplateServiceServer() {
 }
 
@@ -342,6 +406,78 @@ func _WorkflowTemplateService_ListWorkflowTemplatesField_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowTemplateService_SetLatestWorkflowTemplateVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLatestWorkflowTemplateVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).SetLatestWorkflowTemplateVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTemplateService/SetLatestWorkflowTemplateVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).SetLatestWorkflowTemplateVersion(ctx, req.(*SetLatestWorkflowTemplateVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTemplateService_DiffWorkflowTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffWorkflowTemplateVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).DiffWorkflowTemplateVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTemplateService/DiffWorkflowTemplateVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).DiffWorkflowTemplateVersions(ctx, req.(*DiffWorkflowTemplateVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTemplateService_ExportWorkflowTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportWorkflowTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).ExportWorkflowTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTemplateService/ExportWorkflowTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).ExportWorkflowTemplates(ctx, req.(*ExportWorkflowTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTemplateService_ImportWorkflowTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportWorkflowTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTemplateServiceServer).ImportWorkflowTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTemplateService/ImportWorkflowTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTemplateServiceServer).ImportWorkflowTemplates(ctx, req.(*ImportWorkflowTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.WorkflowTemplateService",
 	HandlerType: (*WorkflowTemplateServiceServer)(nil),
@@ -382,6 +518,22 @@ var _WorkflowTemplateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflowTemplatesField",
 			Handler:    _WorkflowTemplateService_ListWorkflowTemplatesField_Handler,
 		},
+		{
+			MethodName: "SetLatestWorkflowTemplateVersion",
+			Handler:    _WorkflowTemplateService_SetLatestWorkflowTemplateVersion_Handler,
+		},
+		{
+			MethodName: "DiffWorkflowTemplateVersions",
+			Handler:    _WorkflowTemplateService_DiffWorkflowTemplateVersions_Handler,
+		},
+		{
+			MethodName: "ExportWorkflowTemplates",
+			Handler:    _WorkflowTemplateService_ExportWorkflowTemplates_Handler,
+		},
+		{
+			MethodName: "ImportWorkflowTemplates",
+			Handler:    _WorkflowTemplateService_ImportWorkflowTemplates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "workflow_template.proto",
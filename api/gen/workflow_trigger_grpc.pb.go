@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package gen
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// WorkflowTriggerServiceClient is the client API for WorkflowTriggerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkflowTriggerServiceClient interface {
+	CreateWorkflowTrigger(ctx context.Context, in *CreateWorkflowTriggerRequest, opts ...grpc.CallOption) (*WorkflowTrigger, error)
+	GetWorkflowTrigger(ctx context.Context, in *GetWorkflowTriggerRequest, opts ...grpc.CallOption) (*WorkflowTrigger, error)
+	ListWorkflowTriggers(ctx context.Context, in *ListWorkflowTriggersRequest, opts ...grpc.CallOption) (*ListWorkflowTriggersResponse, error)
+	DeleteWorkflowTrigger(ctx context.Context, in *DeleteWorkflowTriggerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Start a workflow for a request to the webhook of a webhook trigger.
+	// Over HTTP, the raw body of a POST to /apis/v1beta1/{namespace}/workflow_triggers/{uid}/webhook is the payload,
+	// the X-Onepanel-Timestamp header is the timestamp and the X-Onepanel-Signature header is the signature.
+	ReceiveWorkflowTriggerWebhook(ctx context.Context, in *ReceiveWorkflowTriggerWebhookRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
+}
+
+type workflowTriggerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkflowTriggerServiceClient(cc grpc.ClientConnInterface) WorkflowTriggerServiceClient {
+	return &workflowTriggerServiceClient{cc}
+}
+
+func (c *workflowTriggerServiceClient) CreateWorkflowTrigger(ctx context.Context, in *CreateWorkflowTriggerRequest, opts ...grpc.CallOption) (*WorkflowTrigger, error) {
+	out := new(WorkflowTrigger)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTriggerService/CreateWorkflowTrigger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTriggerServiceClient) GetWorkflowTrigger(ctx context.Context, in *GetWorkflowTriggerRequest, opts ...grpc.CallOption) (*WorkflowTrigger, error) {
+	out := new(WorkflowTrigger)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTriggerService/GetWorkflowTrigger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTriggerServiceClient) ListWorkflowTriggers(ctx context.Context, in *ListWorkflowTriggersRequest, opts ...grpc.CallOption) (*ListWorkflowTriggersResponse, error) {
+	out := new(ListWorkflowTriggersResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTriggerService/ListWorkflowTriggers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTriggerServiceClient) DeleteWorkflowTrigger(ctx context.Context, in *DeleteWorkflowTriggerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTriggerService/DeleteWorkflowTrigger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowTriggerServiceClient) ReceiveWorkflowTriggerWebhook(ctx context.Context, in *ReceiveWorkflowTriggerWebhookRequest, opts ...grpc.CallOption) (*WorkflowExecution, error) {
+	out := new(WorkflowExecution)
+	err := c.cc.Invoke(ctx, "/api.WorkflowTriggerService/ReceiveWorkflowTriggerWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkflowTriggerServiceServer is the server API for WorkflowTriggerService service.
+// All implementations must embed UnimplementedWorkflowTriggerServiceServer
+// for forward compatibility
+type WorkflowTriggerServiceServer interface {
+	CreateWorkflowTrigger(context.Context, *CreateWorkflowTriggerRequest) (*WorkflowTrigger, error)
+	GetWorkflowTrigger(context.Context, *GetWorkflowTriggerRequest) (*WorkflowTrigger, error)
+	ListWorkflowTriggers(context.Context, *ListWorkflowTriggersRequest) (*ListWorkflowTriggersResponse, error)
+	DeleteWorkflowTrigger(context.Context, *DeleteWorkflowTriggerRequest) (*emptypb.Empty, error)
+	// Start a workflow for a request to the webhook of a webhook trigger.
+	// Over HTTP, the raw body of a POST to /apis/v1beta1/{namespace}/workflow_triggers/{uid}/webhook is the payload,
+	// the X-Onepanel-Timestamp header is the timestamp and the X-Onepanel-Signature header is the signature.
+	ReceiveWorkflowTriggerWebhook(context.Context, *ReceiveWorkflowTriggerWebhookRequest) (*WorkflowExecution, error)
+	mustEmbedUnimplementedWorkflowTriggerServiceServer()
+}
+
+// UnimplementedWorkflowTriggerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWorkflowTriggerServiceServer struct {
+}
+
+func (UnimplementedWorkflowTriggerServiceServer) CreateWorkflowTrigger(context.Context, *CreateWorkflowTriggerRequest) (*WorkflowTrigger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflowTrigger not implemented")
+}
+func (UnimplementedWorkflowTriggerServiceServer) GetWorkflowTrigger(context.Context, *GetWorkflowTriggerRequest) (*WorkflowTrigger, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowTrigger not implemented")
+}
+func (UnimplementedWorkflowTriggerServiceServer) ListWorkflowTriggers(context.Context, *ListWorkflowTriggersRequest) (*ListWorkflowTriggersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowTriggers not implemented")
+}
+func (UnimplementedWorkflowTriggerServiceServer) DeleteWorkflowTrigger(context.Context, *DeleteWorkflowTriggerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkflowTrigger not implemented")
+}
+func (UnimplementedWorkflowTriggerServiceServer) ReceiveWorkflowTriggerWebhook(context.Context, *ReceiveWorkflowTriggerWebhookRequest) (*WorkflowExecution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveWorkflowTriggerWebhook not implemented")
+}
+func (UnimplementedWorkflowTriggerServiceServer) mustEmbedUnimplementedWorkflowTriggerServiceServer() {
+}
+
+// UnsafeWorkflowTriggerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkflowTriggerServiceServer will
+// result in compilation errors.
+type UnsafeWorkflowTriggerServiceServer interface {
+	mustEmbedUnimplementedWorkflowTriggerServiceServer()
+}
+
+func RegisterWorkflowTriggerServiceServer(s grpc.ServiceRegistrar, srv WorkflowTriggerServiceServer) {
+	s.RegisterService(&_WorkflowTriggerService_serviceDesc, srv)
+}
+
+func _WorkflowTriggerService_CreateWorkflowTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkflowTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTriggerServiceServer).CreateWorkflowTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTriggerService/CreateWorkflowTrigger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTriggerServiceServer).CreateWorkflowTrigger(ctx, req.(*CreateWorkflowTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTriggerService_GetWorkflowTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTriggerServiceServer).GetWorkflowTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTriggerService/GetWorkflowTrigger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTriggerServiceServer).GetWorkflowTrigger(ctx, req.(*GetWorkflowTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTriggerService_ListWorkflowTriggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowTriggersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTriggerServiceServer).ListWorkflowTriggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTriggerService/ListWorkflowTriggers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTriggerServiceServer).ListWorkflowTriggers(ctx, req.(*ListWorkflowTriggersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTriggerService_DeleteWorkflowTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkflowTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTriggerServiceServer).DeleteWorkflowTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTriggerService/DeleteWorkflowTrigger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTriggerServiceServer).DeleteWorkflowTrigger(ctx, req.(*DeleteWorkflowTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowTriggerService_ReceiveWorkflowTriggerWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveWorkflowTriggerWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowTriggerServiceServer).ReceiveWorkflowTriggerWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowTriggerService/ReceiveWorkflowTriggerWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowTriggerServiceServer).ReceiveWorkflowTriggerWebhook(ctx, req.(*ReceiveWorkflowTriggerWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WorkflowTriggerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.WorkflowTriggerService",
+	HandlerType: (*WorkflowTriggerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWorkflowTrigger",
+			Handler:    _WorkflowTriggerService_CreateWorkflowTrigger_Handler,
+		},
+		{
+			MethodName: "GetWorkflowTrigger",
+			Handler:    _WorkflowTriggerService_GetWorkflowTrigger_Handler,
+		},
+		{
+			MethodName: "ListWorkflowTriggers",
+			Handler:    _WorkflowTriggerService_ListWorkflowTriggers_Handler,
+		},
+		{
+			MethodName: "DeleteWorkflowTrigger",
+			Handler:    _WorkflowTriggerService_DeleteWorkflowTrigger_Handler,
+		},
+		{
+			MethodName: "ReceiveWorkflowTriggerWebhook",
+			Handler:    _WorkflowTriggerService_ReceiveWorkflowTriggerWebhook_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "workflow_trigger.proto",
+}
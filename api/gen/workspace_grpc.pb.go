@@ -19,7 +19,10 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WorkspaceServiceClient interface {
 	CreateWorkspace(ctx context.Context, in *CreateWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error)
+	// Create a workspace with the template version, parameters and, optionally, the volume data of another workspace
+	CloneWorkspace(ctx context.Context, in *CloneWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error)
 	GetWorkspaceStatisticsForNamespace(ctx context.Context, in *GetWorkspaceStatisticsForNamespaceRequest, opts ...grpc.CallOption) (*GetWorkspaceStatisticsForNamespaceResponse, error)
+	GetWorkspaceUsageReport(ctx context.Context, in *GetWorkspaceUsageReportRequest, opts ...grpc.CallOption) (*GetWorkspaceUsageReportResponse, error)
 	GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error)
 	ListWorkspaces(ctx context.Context, in *ListWorkspaceRequest, opts ...grpc.CallOption) (*ListWorkspaceResponse, error)
 	UpdateWorkspaceStatus(ctx context.Context, in *UpdateWorkspaceStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -27,8 +30,27 @@ type WorkspaceServiceClient interface {
 	PauseWorkspace(ctx context.Context, in *PauseWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ResumeWorkspace(ctx context.Context, in *ResumeWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Pause the workspaces that match the labels and phase and are not paused
+	PauseWorkspaces(ctx context.Context, in *BulkWorkspaceActionRequest, opts ...grpc.CallOption) (*BulkWorkspaceActionResponse, error)
+	// Resume the paused workspaces that match the labels and phase
+	ResumeWorkspaces(ctx context.Context, in *BulkWorkspaceActionRequest, opts ...grpc.CallOption) (*BulkWorkspaceActionResponse, error)
+	// Delete the workspaces that match the labels and phase
+	DeleteWorkspaces(ctx context.Context, in *BulkWorkspaceActionRequest, opts ...grpc.CallOption) (*BulkWorkspaceActionResponse, error)
 	RetryLastWorkspaceAction(ctx context.Context, in *RetryActionWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetWorkspaceContainerLogs(ctx context.Context, in *GetWorkspaceContainerLogsRequest, opts ...grpc.CallOption) (WorkspaceService_GetWorkspaceContainerLogsClient, error)
+	RecordWorkspaceActivity(ctx context.Context, in *RecordWorkspaceActivityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateWorkspaceIdleTimeout(ctx context.Context, in *UpdateWorkspaceIdleTimeoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWorkspaceSchedules(ctx context.Context, in *ListWorkspaceSchedulesRequest, opts ...grpc.CallOption) (*ListWorkspaceSchedulesResponse, error)
+	CreateWorkspaceSchedule(ctx context.Context, in *CreateWorkspaceScheduleRequest, opts ...grpc.CallOption) (*WorkspaceSchedule, error)
+	UpdateWorkspaceSchedule(ctx context.Context, in *UpdateWorkspaceScheduleRequest, opts ...grpc.CallOption) (*WorkspaceSchedule, error)
+	DeleteWorkspaceSchedule(ctx context.Context, in *DeleteWorkspaceScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateWorkspaceSnapshot(ctx context.Context, in *CreateWorkspaceSnapshotRequest, opts ...grpc.CallOption) (*WorkspaceSnapshot, error)
+	ListWorkspaceSnapshots(ctx context.Context, in *ListWorkspaceSnapshotsRequest, opts ...grpc.CallOption) (*ListWorkspaceSnapshotsResponse, error)
+	RestoreWorkspaceSnapshot(ctx context.Context, in *RestoreWorkspaceSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWorkspaceCollaborators(ctx context.Context, in *ListWorkspaceCollaboratorsRequest, opts ...grpc.CallOption) (*ListWorkspaceCollaboratorsResponse, error)
+	AddWorkspaceCollaborator(ctx context.Context, in *AddWorkspaceCollaboratorRequest, opts ...grpc.CallOption) (*WorkspaceCollaborator, error)
+	RemoveWorkspaceCollaborator(ctx context.Context, in *RemoveWorkspaceCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWorkspaceEvents(ctx context.Context, in *ListWorkspaceEventsRequest, opts ...grpc.CallOption) (*ListWorkspaceEventsResponse, error)
 	ListWorkspacesField(ctx context.Context, in *ListWorkspacesFieldRequest, opts ...grpc.CallOption) (*ListWorkspacesFieldResponse, error)
 }
 
@@ -49,6 +71,15 @@ func (c *workspaceServiceClient) CreateWorkspace(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *workspaceServiceClient) CloneWorkspace(ctx context.Context, in *CloneWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error) {
+	out := new(Workspace)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/CloneWorkspace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) GetWorkspaceStatisticsForNamespace(ctx context.Context, in *GetWorkspaceStatisticsForNamespaceRequest, opts ...grpc.CallOption) (*GetWorkspaceStatisticsForNamespaceResponse, error) {
 	out := new(GetWorkspaceStatisticsForNamespaceResponse)
 	err := c.cc.Invoke(ctx, "/api.WorkspaceService/GetWorkspaceStatisticsForNamespace", in, out, opts...)
@@ -58,6 +89,15 @@ func (c *workspaceServiceClient) GetWorkspaceStatisticsForNamespace(ctx context.
 	return out, nil
 }
 
+func (c *workspaceServiceClient) GetWorkspaceUsageReport(ctx context.Context, in *GetWorkspaceUsageReportRequest, opts ...grpc.CallOption) (*GetWorkspaceUsageReportResponse, error) {
+	out := new(GetWorkspaceUsageReportResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/GetWorkspaceUsageReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) GetWorkspace(ctx context.Context, in *GetWorkspaceRequest, opts ...grpc.CallOption) (*Workspace, error) {
 	out := new(Workspace)
 	err := c.cc.Invoke(ctx, "/api.WorkspaceService/GetWorkspace", in, out, opts...)
@@ -121,6 +161,33 @@ func (c *workspaceServiceClient) DeleteWorkspace(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *workspaceServiceClient) PauseWorkspaces(ctx context.Context, in *BulkWorkspaceActionRequest, opts ...grpc.CallOption) (*BulkWorkspaceActionResponse, error) {
+	out := new(BulkWorkspaceActionResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/PauseWorkspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ResumeWorkspaces(ctx context.Context, in *BulkWorkspaceActionRequest, opts ...grpc.CallOption) (*BulkWorkspaceActionResponse, error) {
+	out := new(BulkWorkspaceActionResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/ResumeWorkspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteWorkspaces(ctx context.Context, in *BulkWorkspaceActionRequest, opts ...grpc.CallOption) (*BulkWorkspaceActionResponse, error) {
+	out := new(BulkWorkspaceActionResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/DeleteWorkspaces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) RetryLastWorkspaceAction(ctx context.Context, in *RetryActionWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/api.WorkspaceService/RetryLastWorkspaceAction", in, out, opts...)
@@ -162,6 +229,123 @@ func (x *workspaceServiceGetWorkspaceContainerLogsClient) Recv() (*LogStreamResp
 	return m, nil
 }
 
+func (c *workspaceServiceClient) RecordWorkspaceActivity(ctx context.Context, in *RecordWorkspaceActivityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/RecordWorkspaceActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) UpdateWorkspaceIdleTimeout(ctx context.Context, in *UpdateWorkspaceIdleTimeoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/UpdateWorkspaceIdleTimeout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListWorkspaceSchedules(ctx context.Context, in *ListWorkspaceSchedulesRequest, opts ...grpc.CallOption) (*ListWorkspaceSchedulesResponse, error) {
+	out := new(ListWorkspaceSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/ListWorkspaceSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) CreateWorkspaceSchedule(ctx context.Context, in *CreateWorkspaceScheduleRequest, opts ...grpc.CallOption) (*WorkspaceSchedule, error) {
+	out := new(WorkspaceSchedule)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/CreateWorkspaceSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) UpdateWorkspaceSchedule(ctx context.Context, in *UpdateWorkspaceScheduleRequest, opts ...grpc.CallOption) (*WorkspaceSchedule, error) {
+	out := new(WorkspaceSchedule)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/UpdateWorkspaceSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) DeleteWorkspaceSchedule(ctx context.Context, in *DeleteWorkspaceScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/DeleteWorkspaceSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) CreateWorkspaceSnapshot(ctx context.Context, in *CreateWorkspaceSnapshotRequest, opts ...grpc.CallOption) (*WorkspaceSnapshot, error) {
+	out := new(WorkspaceSnapshot)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/CreateWorkspaceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListWorkspaceSnapshots(ctx context.Context, in *ListWorkspaceSnapshotsRequest, opts ...grpc.CallOption) (*ListWorkspaceSnapshotsResponse, error) {
+	out := new(ListWorkspaceSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/ListWorkspaceSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RestoreWorkspaceSnapshot(ctx context.Context, in *RestoreWorkspaceSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/RestoreWorkspaceSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListWorkspaceCollaborators(ctx context.Context, in *ListWorkspaceCollaboratorsRequest, opts ...grpc.CallOption) (*ListWorkspaceCollaboratorsResponse, error) {
+	out := new(ListWorkspaceCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/ListWorkspaceCollaborators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) AddWorkspaceCollaborator(ctx context.Context, in *AddWorkspaceCollaboratorRequest, opts ...grpc.CallOption) (*WorkspaceCollaborator, error) {
+	out := new(WorkspaceCollaborator)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/AddWorkspaceCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) RemoveWorkspaceCollaborator(ctx context.Context, in *RemoveWorkspaceCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/RemoveWorkspaceCollaborator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) ListWorkspaceEvents(ctx context.Context, in *ListWorkspaceEventsRequest, opts ...grpc.CallOption) (*ListWorkspaceEventsResponse, error) {
+	out := new(ListWorkspaceEventsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/ListWorkspaceEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) ListWorkspacesField(ctx context.Context, in *ListWorkspacesFieldRequest, opts ...grpc.CallOption) (*ListWorkspacesFieldResponse, error) {
 	out := new(ListWorkspacesFieldResponse)
 	err := c.cc.Invoke(ctx, "/api.WorkspaceService/ListWorkspacesField", in, out, opts...)
@@ -176,7 +360,10 @@ func (c *workspaceServiceClient) ListWorkspacesField(ctx context.Context, in *Li
 // for forward compatibility
 type WorkspaceServiceServer interface {
 	CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*Workspace, error)
+	// Create a workspace with the template version, parameters and, optionally, the volume data of another workspace
+	CloneWorkspace(context.Context, *CloneWorkspaceRequest) (*Workspace, error)
 	GetWorkspaceStatisticsForNamespace(context.Context, *GetWorkspaceStatisticsForNamespaceRequest) (*GetWorkspaceStatisticsForNamespaceResponse, error)
+	GetWorkspaceUsageReport(context.Context, *GetWorkspaceUsageReportRequest) (*GetWorkspaceUsageReportResponse, error)
 	GetWorkspace(context.Context, *GetWorkspaceRequest) (*Workspace, error)
 	ListWorkspaces(context.Context, *ListWorkspaceRequest) (*ListWorkspaceResponse, error)
 	UpdateWorkspaceStatus(context.Context, *UpdateWorkspaceStatusRequest) (*emptypb.Empty, error)
@@ -184,8 +371,27 @@ type WorkspaceServiceServer interface {
 	PauseWorkspace(context.Context, *PauseWorkspaceRequest) (*emptypb.Empty, error)
 	ResumeWorkspace(context.Context, *ResumeWorkspaceRequest) (*emptypb.Empty, error)
 	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*emptypb.Empty, error)
+	// Pause the workspaces that match the labels and phase and are not paused
+	PauseWorkspaces(context.Context, *BulkWorkspaceActionRequest) (*BulkWorkspaceActionResponse, error)
+	// Resume the paused workspaces that match the labels and phase
+	ResumeWorkspaces(context.Context, *BulkWorkspaceActionRequest) (*BulkWorkspaceActionResponse, error)
+	// Delete the workspaces that match the labels and phase
+	DeleteWorkspaces(context.Context, *BulkWorkspaceActionRequest) (*BulkWorkspaceActionResponse, error)
 	RetryLastWorkspaceAction(context.Context, *RetryActionWorkspaceRequest) (*emptypb.Empty, error)
 	GetWorkspaceContainerLogs(*GetWorkspaceContainerLogsRequest, WorkspaceService_GetWorkspaceContainerLogsServer) error
+	RecordWorkspaceActivity(context.Context, *RecordWorkspaceActivityRequest) (*emptypb.Empty, error)
+	UpdateWorkspaceIdleTimeout(context.Context, *UpdateWorkspaceIdleTimeoutRequest) (*emptypb.Empty, error)
+	ListWorkspaceSchedules(context.Context, *ListWorkspaceSchedulesRequest) (*ListWorkspaceSchedulesResponse, error)
+	CreateWorkspaceSchedule(context.Context, *CreateWorkspaceScheduleRequest) (*WorkspaceSchedule, error)
+	UpdateWorkspaceSchedule(context.Context, *UpdateWorkspaceScheduleRequest) (*WorkspaceSchedule, error)
+	DeleteWorkspaceSchedule(context.Context, *DeleteWorkspaceScheduleRequest) (*emptypb.Empty, error)
+	CreateWorkspaceSnapshot(context.Context, *CreateWorkspaceSnapshotRequest) (*WorkspaceSnapshot, error)
+	ListWorkspaceSnapshots(context.Context, *ListWorkspaceSnapshotsRequest) (*ListWorkspaceSnapshotsResponse, error)
+	RestoreWorkspaceSnapshot(context.Context, *RestoreWorkspaceSnapshotRequest) (*emptypb.Empty, error)
+	ListWorkspaceCollaborators(context.Context, *ListWorkspaceCollaboratorsRequest) (*ListWorkspaceCollaboratorsResponse, error)
+	AddWorkspaceCollaborator(context.Context, *AddWorkspaceCollaboratorRequest) (*WorkspaceCollaborator, error)
+	RemoveWorkspaceCollaborator(context.Context, *RemoveWorkspaceCollaboratorRequest) (*emptypb.Empty, error)
+	ListWorkspaceEvents(context.Context, *ListWorkspaceEventsRequest) (*ListWorkspaceEventsResponse, error)
 	ListWorkspacesField(context.Context, *ListWorkspacesFieldRequest) (*ListWorkspacesFieldResponse, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
@@ -197,9 +403,15 @@ type UnimplementedWorkspaceServiceServer struct {
 func (UnimplementedWorkspaceServiceServer) CreateWorkspace(context.Context, *CreateWorkspaceRequest) (*Workspace, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspace not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) CloneWorkspace(context.Context, *CloneWorkspaceRequest) (*Workspace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneWorkspace not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) GetWorkspaceStatisticsForNamespace(context.Context, *GetWorkspaceStatisticsForNamespaceRequest) (*GetWorkspaceStatisticsForNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceStatisticsForNamespace not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceUsageReport(context.Context, *GetWorkspaceUsageReportRequest) (*GetWorkspaceUsageReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceUsageReport not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) GetWorkspace(context.Context, *GetWorkspaceRequest) (*Workspace, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspace not implemented")
 }
@@ -221,12 +433,60 @@ func (UnimplementedWorkspaceServiceServer) ResumeWorkspace(context.Context, *Res
 func (UnimplementedWorkspaceServiceServer) DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspace not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) PauseWorkspaces(context.Context, *BulkWorkspaceActionRequest) (*BulkWorkspaceActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWorkspaces not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ResumeWorkspaces(context.Context, *BulkWorkspaceActionRequest) (*BulkWorkspaceActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkspaces not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteWorkspaces(context.Context, *BulkWorkspaceActionRequest) (*BulkWorkspaceActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspaces not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) RetryLastWorkspaceAction(context.Context, *RetryActionWorkspaceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryLastWorkspaceAction not implemented")
 }
 func (UnimplementedWorkspaceServiceServer) GetWorkspaceContainerLogs(*GetWorkspaceContainerLogsRequest, WorkspaceService_GetWorkspaceContainerLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetWorkspaceContainerLogs not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) RecordWorkspaceActivity(context.Context, *RecordWorkspaceActivityRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordWorkspaceActivity not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceIdleTimeout(context.Context, *UpdateWorkspaceIdleTimeoutRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceIdleTimeout not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListWorkspaceSchedules(context.Context, *ListWorkspaceSchedulesRequest) (*ListWorkspaceSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceSchedules not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) CreateWorkspaceSchedule(context.Context, *CreateWorkspaceScheduleRequest) (*WorkspaceSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceSchedule not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceSchedule(context.Context, *UpdateWorkspaceScheduleRequest) (*WorkspaceSchedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceSchedule not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) DeleteWorkspaceSchedule(context.Context, *DeleteWorkspaceScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspaceSchedule not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) CreateWorkspaceSnapshot(context.Context, *CreateWorkspaceSnapshotRequest) (*WorkspaceSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkspaceSnapshot not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListWorkspaceSnapshots(context.Context, *ListWorkspaceSnapshotsRequest) (*ListWorkspaceSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceSnapshots not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RestoreWorkspaceSnapshot(context.Context, *RestoreWorkspaceSnapshotRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreWorkspaceSnapshot not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListWorkspaceCollaborators(context.Context, *ListWorkspaceCollaboratorsRequest) (*ListWorkspaceCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceCollaborators not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) AddWorkspaceCollaborator(context.Context, *AddWorkspaceCollaboratorRequest) (*WorkspaceCollaborator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorkspaceCollaborator not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) RemoveWorkspaceCollaborator(context.Context, *RemoveWorkspaceCollaboratorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWorkspaceCollaborator not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) ListWorkspaceEvents(context.Context, *ListWorkspaceEventsRequest) (*ListWorkspaceEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceEvents not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) ListWorkspacesField(context.Context, *ListWorkspacesFieldRequest) (*ListWorkspacesFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspacesField not implemented")
 }
@@ -261,6 +521,24 @@ func _WorkspaceService_CreateWorkspace_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_CloneWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneWorkspaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CloneWorkspace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/CloneWorkspace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CloneWorkspace(ctx, req.(*CloneWorkspaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_GetWorkspaceStatisticsForNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWorkspaceStatisticsForNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -279,6 +557,24 @@ func _WorkspaceService_GetWorkspaceStatisticsForNamespace_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_GetWorkspaceUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/GetWorkspaceUsageReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceUsageReport(ctx, req.(*GetWorkspaceUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_GetWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWorkspaceRequest)
 	if err := dec(in); err != nil {
@@ -405,6 +701,60 @@ func _WorkspaceService_DeleteWorkspace_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_PauseWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkWorkspaceActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).PauseWorkspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/PauseWorkspaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).PauseWorkspaces(ctx, req.(*BulkWorkspaceActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ResumeWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkWorkspaceActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ResumeWorkspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/ResumeWorkspaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ResumeWorkspaces(ctx, req.(*BulkWorkspaceActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteWorkspaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkWorkspaceActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteWorkspaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/DeleteWorkspaces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteWorkspaces(ctx, req.(*BulkWorkspaceActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_RetryLastWorkspaceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetryActionWorkspaceRequest)
 	if err := dec(in); err != nil {
@@ -444,6 +794,240 @@ func (x *workspaceServiceGetWorkspaceContainerLogsServer) Send(m *LogStreamRespo
 	return x.ServerStream.SendMsg(m)
 }
 
+func _WorkspaceService_RecordWorkspaceActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordWorkspaceActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RecordWorkspaceActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/RecordWorkspaceActivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RecordWorkspaceActivity(ctx, req.(*RecordWorkspaceActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_UpdateWorkspaceIdleTimeout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceIdleTimeoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).UpdateWorkspaceIdleTimeout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/UpdateWorkspaceIdleTimeout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).UpdateWorkspaceIdleTimeout(ctx, req.(*UpdateWorkspaceIdleTimeoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListWorkspaceSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListWorkspaceSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/ListWorkspaceSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListWorkspaceSchedules(ctx, req.(*ListWorkspaceSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_CreateWorkspaceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CreateWorkspaceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/CreateWorkspaceSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CreateWorkspaceSchedule(ctx, req.(*CreateWorkspaceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_UpdateWorkspaceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).UpdateWorkspaceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/UpdateWorkspaceSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).UpdateWorkspaceSchedule(ctx, req.(*UpdateWorkspaceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_DeleteWorkspaceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkspaceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).DeleteWorkspaceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/DeleteWorkspaceSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).DeleteWorkspaceSchedule(ctx, req.(*DeleteWorkspaceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_CreateWorkspaceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkspaceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).CreateWorkspaceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/CreateWorkspaceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).CreateWorkspaceSnapshot(ctx, req.(*CreateWorkspaceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListWorkspaceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListWorkspaceSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/ListWorkspaceSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListWorkspaceSnapshots(ctx, req.(*ListWorkspaceSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RestoreWorkspaceSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreWorkspaceSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RestoreWorkspaceSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/RestoreWorkspaceSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RestoreWorkspaceSnapshot(ctx, req.(*RestoreWorkspaceSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListWorkspaceCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListWorkspaceCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/ListWorkspaceCollaborators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListWorkspaceCollaborators(ctx, req.(*ListWorkspaceCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_AddWorkspaceCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWorkspaceCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).AddWorkspaceCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/AddWorkspaceCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).AddWorkspaceCollaborator(ctx, req.(*AddWorkspaceCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_RemoveWorkspaceCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWorkspaceCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).RemoveWorkspaceCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/RemoveWorkspaceCollaborator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).RemoveWorkspaceCollaborator(ctx, req.(*RemoveWorkspaceCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_ListWorkspaceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListWorkspaceEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/ListWorkspaceEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListWorkspaceEvents(ctx, req.(*ListWorkspaceEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_ListWorkspacesField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListWorkspacesFieldRequest)
 	if err := dec(in); err != nil {
@@ -470,10 +1054,18 @@ var _WorkspaceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateWorkspace",
 			Handler:    _WorkspaceService_CreateWorkspace_Handler,
 		},
+		{
+			MethodName: "CloneWorkspace",
+			Handler:    _WorkspaceService_CloneWorkspace_Handler,
+		},
 		{
 			MethodName: "GetWorkspaceStatisticsForNamespace",
 			Handler:    _WorkspaceService_GetWorkspaceStatisticsForNamespace_Handler,
 		},
+		{
+			MethodName: "GetWorkspaceUsageReport",
+			Handler:    _WorkspaceService_GetWorkspaceUsageReport_Handler,
+		},
 		{
 			MethodName: "GetWorkspace",
 			Handler:    _WorkspaceService_GetWorkspace_Handler,
@@ -502,10 +1094,74 @@ var _WorkspaceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkspace",
 			Handler:    _WorkspaceService_DeleteWorkspace_Handler,
 		},
+		{
+			MethodName: "PauseWorkspaces",
+			Handler:    _WorkspaceService_PauseWorkspaces_Handler,
+		},
+		{
+			MethodName: "ResumeWorkspaces",
+			Handler:    _WorkspaceService_ResumeWorkspaces_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspaces",
+			Handler:    _WorkspaceService_DeleteWorkspaces_Handler,
+		},
 		{
 			MethodName: "RetryLastWorkspaceAction",
 			Handler:    _WorkspaceService_RetryLastWorkspaceAction_Handler,
 		},
+		{
+			MethodName: "RecordWorkspaceActivity",
+			Handler:    _WorkspaceService_RecordWorkspaceActivity_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspaceIdleTimeout",
+			Handler:    _WorkspaceService_UpdateWorkspaceIdleTimeout_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceSchedules",
+			Handler:    _WorkspaceService_ListWorkspaceSchedules_Handler,
+		},
+		{
+			MethodName: "CreateWorkspaceSchedule",
+			Handler:    _WorkspaceService_CreateWorkspaceSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateWorkspaceSchedule",
+			Handler:    _WorkspaceService_UpdateWorkspaceSchedule_Handler,
+		},
+		{
+			MethodName: "DeleteWorkspaceSchedule",
+			Handler:    _WorkspaceService_DeleteWorkspaceSchedule_Handler,
+		},
+		{
+			MethodName: "CreateWorkspaceSnapshot",
+			Handler:    _WorkspaceService_CreateWorkspaceSnapshot_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceSnapshots",
+			Handler:    _WorkspaceService_ListWorkspaceSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreWorkspaceSnapshot",
+			Handler:    _WorkspaceService_RestoreWorkspaceSnapshot_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceCollaborators",
+			Handler:    _WorkspaceService_ListWorkspaceCollaborators_Handler,
+		},
+		{
+			MethodName: "AddWorkspaceCollaborator",
+			Handler:    _WorkspaceService_AddWorkspaceCollaborator_Handler,
+		},
+		{
+			MethodName: "RemoveWorkspaceCollaborator",
+			Handler:    _WorkspaceService_RemoveWorkspaceCollaborator_Handler,
+		},
+		{
+			MethodName: "ListWorkspaceEvents",
+			Handler:    _WorkspaceService_ListWorkspaceEvents_Handler,
+		},
 		{
 			MethodName: "ListWorkspacesField",
 			Handler:    _WorkspaceService_ListWorkspacesField_Handler,
@@ -29,6 +29,10 @@ type WorkspaceTemplateServiceClient interface {
 	GetWorkspaceTemplate(ctx context.Context, in *GetWorkspaceTemplateRequest, opts ...grpc.CallOption) (*WorkspaceTemplate, error)
 	ListWorkspaceTemplates(ctx context.Context, in *ListWorkspaceTemplatesRequest, opts ...grpc.CallOption) (*ListWorkspaceTemplatesResponse, error)
 	ListWorkspaceTemplateVersions(ctx context.Context, in *ListWorkspaceTemplateVersionsRequest, opts ...grpc.CallOption) (*ListWorkspaceTemplateVersionsResponse, error)
+	// Make a previous version of a workspace template the latest one, or create a new version from it if clone is true
+	SetLatestWorkspaceTemplateVersion(ctx context.Context, in *SetLatestWorkspaceTemplateVersionRequest, opts ...grpc.CallOption) (*WorkspaceTemplate, error)
+	// Compare two versions of a workspace template. A version of 0 is the latest version.
+	DiffWorkspaceTemplateVersions(ctx context.Context, in *DiffWorkspaceTemplateVersionsRequest, opts ...grpc.CallOption) (*TemplateVersionDiff, error)
 	ListWorkspaceTemplatesField(ctx context.Context, in *ListWorkspaceTemplatesFieldRequest, opts ...grpc.CallOption) (*ListWorkspaceTemplatesFieldResponse, error)
 }
 
@@ -103,6 +107,24 @@ func (c *workspaceTemplateServiceClient) ListWorkspaceTemplateVersions(ctx conte
 	return out, nil
 }
 
+func (c *workspaceTemplateServiceClient) SetLatestWorkspaceTemplateVersion(ctx context.Context, in *SetLatestWorkspaceTemplateVersionRequest, opts ...grpc.CallOption) (*WorkspaceTemplate, error) {
+	out := new(WorkspaceTemplate)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceTemplateService/SetLatestWorkspaceTemplateVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceTemplateServiceClient) DiffWorkspaceTemplateVersions(ctx context.Context, in *DiffWorkspaceTemplateVersionsRequest, opts ...grpc.CallOption) (*TemplateVersionDiff, error) {
+	out := new(TemplateVersionDiff)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceTemplateService/DiffWorkspaceTemplateVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceTemplateServiceClient) ListWorkspaceTemplatesField(ctx context.Context, in *ListWorkspaceTemplatesFieldRequest, opts ...grpc.CallOption) (*ListWorkspaceTemplatesFieldResponse, error) {
 	out := new(ListWorkspaceTemplatesFieldResponse)
 	err := c.cc.Invoke(ctx, "/api.WorkspaceTemplateService/ListWorkspaceTemplatesField", in, out, opts...)
@@ -128,6 +150,10 @@ type WorkspaceTemplateServiceServer interface {
 	GetWorkspaceTemplate(context.Context, *GetWorkspaceTemplateRequest) (*WorkspaceTemplate, error)
 	ListWorkspaceTemplates(context.Context, *ListWorkspaceTemplatesRequest) (*ListWorkspaceTemplatesResponse, error)
 	ListWorkspaceTemplateVersions(context.Context, *ListWorkspaceTemplateVersionsRequest) (*ListWorkspaceTemplateVersionsResponse, error)
+	// Make a previous version of a workspace template the latest one, or create a new version from it if clone is true
+	SetLatestWorkspaceTemplateVersion(context.Context, *SetLatestWorkspaceTemplateVersionRequest) (*WorkspaceTemplate, error)
+	// Compare two versions of a workspace template. A version of 0 is the latest version.
+	DiffWorkspaceTemplateVersions(context.Context, *DiffWorkspaceTemplateVersionsRequest) (*TemplateVersionDiff, error)
 	ListWorkspaceTemplatesField(context.Context, *ListWorkspaceTemplatesFieldRequest) (*ListWorkspaceTemplatesFieldResponse, error)
 	mustEmbedUnimplementedWorkspaceTemplateServiceServer()
 }
@@ -157,6 +183,12 @@ func (UnimplementedWorkspaceTemplateServiceServer) ListWorkspaceTemplates(contex
 func (UnimplementedWorkspaceTemplateServiceServer) ListWorkspaceTemplateVersions(context.Context, *ListWorkspaceTemplateVersionsRequest) (*ListWorkspaceTemplateVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceTemplateVersions not implemented")
 }
+func (UnimplementedWorkspaceTemplateServiceServer) SetLatestWorkspaceTemplateVersion(context.Context, *SetLatestWorkspaceTemplateVersionRequest) (*WorkspaceTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLatestWorkspaceTemplateVersion not implemented")
+}
+func (UnimplementedWorkspaceTemplateServiceServer) DiffWorkspaceTemplateVersions(context.Context, *DiffWorkspaceTemplateVersionsRequest) (*TemplateVersionDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffWorkspaceTemplateVersions not implemented")
+}
 func (UnimplementedWorkspaceTemplateServiceServer) ListWorkspaceTemplatesField(context.Context, *ListWorkspaceTemplatesFieldRequest) (*ListWorkspaceTemplatesFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceTemplatesField not implemented")
 }
@@ -300,6 +332,42 @@ func _WorkspaceTemplateService_ListWorkspaceTemplateVersions_Handler(srv interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceTemplateService_SetLatestWorkspaceTemplateVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLatestWorkspaceTemplateVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceTemplateServiceServer).SetLatestWorkspaceTemplateVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceTemplateService/SetLatestWorkspaceTemplateVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceTemplateServiceServer).SetLatestWorkspaceTemplateVersion(ctx, req.(*SetLatestWorkspaceTemplateVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceTemplateService_DiffWorkspaceTemplateVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffWorkspaceTemplateVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceTemplateServiceServer).DiffWorkspaceTemplateVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceTemplateService/DiffWorkspaceTemplateVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceTemplateServiceServer).DiffWorkspaceTemplateVersions(ctx, req.(*DiffWorkspaceTemplateVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceTemplateService_ListWorkspaceTemplatesField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListWorkspaceTemplatesFieldRequest)
 	if err := dec(in); err != nil {
@@ -350,6 +418,14 @@ var _WorkspaceTemplateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkspaceTemplateVersions",
 			Handler:    _WorkspaceTemplateService_ListWorkspaceTemplateVersions_Handler,
 		},
+		{
+			MethodName: "SetLatestWorkspaceTemplateVersion",
+			Handler:    _WorkspaceTemplateService_SetLatestWorkspaceTemplateVersion_Handler,
+		},
+		{
+			MethodName: "DiffWorkspaceTemplateVersions",
+			Handler:    _WorkspaceTemplateService_DiffWorkspaceTemplateVersions_Handler,
+		},
 		{
 			MethodName: "ListWorkspaceTemplatesField",
 			Handler:    _WorkspaceTemplateService_ListWorkspaceTemplatesField_Handler,
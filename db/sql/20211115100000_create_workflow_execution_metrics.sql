@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE workflow_execution_metrics
+(
+    id                    serial PRIMARY KEY,
+    workflow_execution_id integer          NOT NULL REFERENCES workflow_executions ON DELETE CASCADE,
+    name                  varchar(255)     NOT NULL CHECK (name <> ''),
+    step                  bigint           NOT NULL DEFAULT 0,
+    value                 double precision NOT NULL,
+    format                varchar(255)     NOT NULL DEFAULT '',
+
+    -- auditing info
+    created_at            timestamp        NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+CREATE INDEX workflow_execution_metrics_workflow_execution_id_name_step ON workflow_execution_metrics (workflow_execution_id, name, step);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE workflow_execution_metrics;
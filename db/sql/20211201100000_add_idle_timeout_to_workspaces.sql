@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE workspaces ADD COLUMN idle_timeout_minutes integer;
+ALTER TABLE workspaces ADD COLUMN last_activity_at timestamp;
+ALTER TABLE workspaces ADD COLUMN paused_reason varchar(255);
+
+-- +goose Down
+ALTER TABLE workspaces DROP COLUMN paused_reason;
+ALTER TABLE workspaces DROP COLUMN last_activity_at;
+ALTER TABLE workspaces DROP COLUMN idle_timeout_minutes;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE workspace_schedules
+(
+    id           serial PRIMARY KEY,
+    workspace_id integer      NOT NULL REFERENCES workspaces ON DELETE CASCADE,
+    action       varchar(255) NOT NULL CHECK (action IN ('resume', 'pause')),
+    schedule     varchar(255) NOT NULL CHECK (schedule <> ''),
+    timezone     varchar(255) NOT NULL DEFAULT 'UTC',
+    last_run_at  timestamp,
+
+    -- auditing info
+    created_at   timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc'),
+    modified_at  timestamp
+);
+CREATE INDEX workspace_schedules_workspace_id ON workspace_schedules (workspace_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE workspace_schedules;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE workspace_snapshots
+(
+    id           serial PRIMARY KEY,
+    uid          varchar(255) NOT NULL CHECK (uid <> ''),
+    name         varchar(255) NOT NULL CHECK (name <> ''),
+    namespace    varchar(255) NOT NULL,
+    workspace_id integer      NOT NULL REFERENCES workspaces ON DELETE CASCADE,
+    volumes      jsonb        NOT NULL DEFAULT '[]',
+
+    -- auditing info
+    created_at   timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+CREATE UNIQUE INDEX workspace_snapshots_namespace_uid ON workspace_snapshots (namespace, uid);
+CREATE INDEX workspace_snapshots_workspace_id ON workspace_snapshots (workspace_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE workspace_snapshots;
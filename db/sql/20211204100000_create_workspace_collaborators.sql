@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE workspaces ADD COLUMN owner varchar(255);
+
+CREATE TABLE workspace_collaborators
+(
+    id           serial PRIMARY KEY,
+    workspace_id integer      NOT NULL REFERENCES workspaces ON DELETE CASCADE,
+    username     varchar(255) NOT NULL CHECK (username <> ''),
+    role         varchar(255) NOT NULL CHECK (role IN ('editor', 'viewer')),
+
+    -- auditing info
+    created_at   timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc'),
+    modified_at  timestamp
+);
+CREATE UNIQUE INDEX workspace_collaborators_workspace_id_username ON workspace_collaborators (workspace_id, username);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE workspace_collaborators;
+ALTER TABLE workspaces DROP COLUMN owner;
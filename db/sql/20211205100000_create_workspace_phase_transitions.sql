@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE workspace_phase_transitions
+(
+    id           serial PRIMARY KEY,
+    workspace_id integer      NOT NULL REFERENCES workspaces ON DELETE CASCADE,
+    phase        varchar(50)  NOT NULL,
+    node_pool    varchar(255),
+
+    -- auditing info
+    created_at   timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+CREATE INDEX workspace_phase_transitions_workspace_id_created_at ON workspace_phase_transitions (workspace_id, created_at);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE workspace_phase_transitions;
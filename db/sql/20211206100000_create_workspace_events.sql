@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE workspace_events
+(
+    id                serial PRIMARY KEY,
+    workspace_id      integer      NOT NULL REFERENCES workspaces ON DELETE CASCADE,
+    action            varchar(50)  NOT NULL,
+    actor             varchar(255),
+    old_phase         varchar(50)  NOT NULL,
+    new_phase         varchar(50)  NOT NULL,
+    parameter_changes jsonb        NOT NULL DEFAULT '[]'::jsonb,
+    error_message     text,
+
+    -- auditing info
+    created_at        timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+CREATE INDEX workspace_events_workspace_id_created_at ON workspace_events (workspace_id, created_at);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE workspace_events;
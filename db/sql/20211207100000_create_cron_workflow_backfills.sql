@@ -0,0 +1,33 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE cron_workflow_backfills
+(
+    id               serial PRIMARY KEY,
+    uid              varchar(255) NOT NULL CHECK (uid <> ''),
+    cron_workflow_id integer      NOT NULL REFERENCES cron_workflows ON DELETE CASCADE,
+    start_time       timestamp    NOT NULL,
+    end_time         timestamp    NOT NULL,
+    parallelism      integer      NOT NULL CHECK (parallelism > 0),
+    phase            varchar(50)  NOT NULL,
+    finished_at      timestamp,
+
+    -- auditing info
+    created_at       timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+CREATE UNIQUE INDEX cron_workflow_backfills_cron_workflow_id_uid ON cron_workflow_backfills (cron_workflow_id, uid);
+CREATE INDEX cron_workflow_backfills_phase ON cron_workflow_backfills (phase);
+
+CREATE TABLE cron_workflow_backfill_runs
+(
+    id             serial PRIMARY KEY,
+    backfill_id    integer      NOT NULL REFERENCES cron_workflow_backfills ON DELETE CASCADE,
+    scheduled_time timestamp    NOT NULL,
+    workflow_name  varchar(255),
+    launched_at    timestamp
+);
+CREATE INDEX cron_workflow_backfill_runs_backfill_id_scheduled_time ON cron_workflow_backfill_runs (backfill_id, scheduled_time);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE cron_workflow_backfill_runs;
+DROP TABLE cron_workflow_backfills;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE workflow_triggers
+(
+    id                           serial PRIMARY KEY,
+    uid                          varchar(255) NOT NULL CHECK (uid <> ''),
+    name                         varchar(255) NOT NULL CHECK (name <> ''),
+    namespace                    varchar(255) NOT NULL,
+    type                         varchar(50)  NOT NULL CHECK (type IN ('webhook')),
+    workflow_template_version_id integer      NOT NULL REFERENCES workflow_template_versions ON DELETE CASCADE,
+    parameters                   jsonb        NOT NULL DEFAULT '[]',
+    last_triggered_at            timestamp,
+
+    -- auditing info
+    created_at                   timestamp    NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+CREATE UNIQUE INDEX workflow_triggers_namespace_uid ON workflow_triggers (namespace, uid);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE workflow_triggers;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE workflow_triggers DROP CONSTRAINT workflow_triggers_type_check;
+ALTER TABLE workflow_triggers ADD CONSTRAINT workflow_triggers_type_check CHECK (type IN ('webhook', 'artifact'));
+ALTER TABLE workflow_triggers ADD COLUMN prefix varchar(1024) NOT NULL DEFAULT '';
+ALTER TABLE workflow_triggers ADD COLUMN pattern varchar(1024) NOT NULL DEFAULT '';
+ALTER TABLE workflow_triggers ADD COLUMN polled_at timestamp;
+
+CREATE TABLE workflow_trigger_objects
+(
+    id            serial PRIMARY KEY,
+    trigger_id    integer       NOT NULL REFERENCES workflow_triggers ON DELETE CASCADE,
+    key           varchar(1024) NOT NULL,
+    workflow_name varchar(255),
+
+    -- auditing info
+    created_at    timestamp     NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+CREATE UNIQUE INDEX workflow_trigger_objects_trigger_id_key ON workflow_trigger_objects (trigger_id, key);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE workflow_trigger_objects;
+DELETE FROM workflow_triggers WHERE type = 'artifact';
+ALTER TABLE workflow_triggers DROP COLUMN polled_at;
+ALTER TABLE workflow_triggers DROP COLUMN pattern;
+ALTER TABLE workflow_triggers DROP COLUMN prefix;
+ALTER TABLE workflow_triggers DROP CONSTRAINT workflow_triggers_type_check;
+ALTER TABLE workflow_triggers ADD CONSTRAINT workflow_triggers_type_check CHECK (type IN ('webhook'));
@@ -6,11 +6,13 @@ import (
 	"fmt"
 	migrations "github.com/onepanelio/core/db/go"
 	"github.com/pressly/goose"
+	"io/ioutil"
 	"math"
 	"net"
 	"net/http"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gorilla/handlers"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -99,9 +101,11 @@ func main() {
 			db = sqlx.MustConnect(dbDriverName, databaseDataSourceName)
 
 			s := startRPCServer(v1.NewDB(db), kubeConfig, sysConfig, stopCh)
+			controllersStopCh := startControllers(v1.NewDB(db), kubeConfig, sysConfig)
 
 			<-stopCh
 
+			close(controllersStopCh)
 			s.Stop()
 			if err := db.Close(); err != nil {
 				log.Printf("[error] closing db connection %v", err.Error())
@@ -159,6 +163,7 @@ func startRPCServer(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig,
 	api.RegisterServiceServiceServer(s, server.NewServiceServer())
 	api.RegisterFileServiceServer(s, server.NewFileServer())
 	api.RegisterInferenceServiceServer(s, server.NewInferenceService())
+	api.RegisterWorkflowTriggerServiceServer(s, server.NewWorkflowTriggerServer())
 
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -171,6 +176,43 @@ func startRPCServer(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig,
 	return s
 }
 
+// startControllers starts the background controllers of the API server.
+// They run until the returned channel is closed.
+func startControllers(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig) chan struct{} {
+	stopCh := make(chan struct{})
+
+	client, err := v1.NewClient(kubeConfig, db, sysConfig)
+	if err != nil {
+		log.Fatalf("Failed to create controller client: %v", err)
+	}
+
+	idleCheckInterval, err := time.ParseDuration(env.Get("WORKSPACE_IDLE_CHECK_INTERVAL", "1m"))
+	if err != nil {
+		log.Fatalf("Failed to parse WORKSPACE_IDLE_CHECK_INTERVAL: %v", err)
+	}
+	go client.RunWorkspaceIdleController(idleCheckInterval, stopCh)
+
+	scheduleCheckInterval, err := time.ParseDuration(env.Get("WORKSPACE_SCHEDULE_CHECK_INTERVAL", "1m"))
+	if err != nil {
+		log.Fatalf("Failed to parse WORKSPACE_SCHEDULE_CHECK_INTERVAL: %v", err)
+	}
+	go client.RunWorkspaceScheduleController(scheduleCheckInterval, stopCh)
+
+	backfillCheckInterval, err := time.ParseDuration(env.Get("CRON_WORKFLOW_BACKFILL_CHECK_INTERVAL", "1m"))
+	if err != nil {
+		log.Fatalf("Failed to parse CRON_WORKFLOW_BACKFILL_CHECK_INTERVAL: %v", err)
+	}
+	go client.RunCronWorkflowBackfillController(backfillCheckInterval, stopCh)
+
+	artifactTriggerCheckInterval, err := time.ParseDuration(env.Get("ARTIFACT_TRIGGER_CHECK_INTERVAL", "1m"))
+	if err != nil {
+		log.Fatalf("Failed to parse ARTIFACT_TRIGGER_CHECK_INTERVAL: %v", err)
+	}
+	go client.RunArtifactWorkflowTriggerController(artifactTriggerCheckInterval, stopCh)
+
+	return stopCh
+}
+
 func startHTTPProxy() {
 	endpoint := "localhost" + *rpcPort
 	ctx := context.Background()
@@ -179,7 +221,7 @@ func startHTTPProxy() {
 
 	// Register gRPC server endpoint
 	// Note: Make sure the gRPC server is running properly and accessible
-	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(customHeaderMatcher))
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(customHeaderMatcher), runtime.WithOutgoingHeaderMatcher(customOutgoingHeaderMatcher))
 	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt64),
 		grpc.MaxCallRecvMsgSize(math.MaxInt64))}
 
@@ -196,6 +238,8 @@ func startHTTPProxy() {
 	registerHandler(api.RegisterServiceServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterFileServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterInferenceServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterWorkflowTriggerServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(registerWorkflowTriggerWebhookHandler, ctx, mux, endpoint, opts)
 
 	log.Printf("Starting HTTP proxy on port %v", *httpPort)
 
@@ -228,6 +272,49 @@ func registerHandler(register registerFunc, ctx context.Context, mux *runtime.Se
 	}
 }
 
+// workflowTriggerWebhookMaxBytes is the largest payload accepted by the webhooks of workflow triggers
+const workflowTriggerWebhookMaxBytes = 1 << 20
+
+// registerWorkflowTriggerWebhookHandler handles the requests to the webhooks of workflow triggers.
+// The raw body is passed on as the payload, as its signature has to be checked against the exact bytes that were sent.
+// Bodies larger than workflowTriggerWebhookMaxBytes are rejected.
+func registerWorkflowTriggerWebhookHandler(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	conn, err := grpc.DialContext(ctx, endpoint, opts...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	client := api.NewWorkflowTriggerServiceClient(conn)
+
+	return mux.HandlePath("POST", "/apis/v1beta1/{namespace}/workflow_triggers/{uid}/webhook", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+
+		payload, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, workflowTriggerWebhookMaxBytes))
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		resp, err := client.ReceiveWorkflowTriggerWebhook(r.Context(), &api.ReceiveWorkflowTriggerWebhookRequest{
+			Namespace: pathParams["namespace"],
+			Uid:       pathParams["uid"],
+			Payload:   payload,
+			Signature: r.Header.Get(v1.WorkflowTriggerSignatureHeader),
+			Timestamp: r.Header.Get(v1.WorkflowTriggerTimestampHeader),
+		})
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+			return
+		}
+
+		runtime.ForwardResponseMessage(r.Context(), mux, outboundMarshaler, w, r, resp)
+	})
+}
+
 // watchConfigmapChanges sets up a listener for configmap changes and calls the onChange function when it happens
 func watchConfigmapChanges(namespace string, stopCh <-chan struct{}, onChange func(*corev1.ConfigMap) error) {
 	client, err := kubernetes.NewForConfig(v1.NewConfig())
@@ -296,3 +383,15 @@ func customHeaderMatcher(key string) (string, bool) {
 		return runtime.DefaultHeaderMatcher(key)
 	}
 }
+
+// customOutgoingHeaderMatcher passes the headers set by the RPC server for file downloads as is
+// and prefixes the others, as done by default.
+func customOutgoingHeaderMatcher(key string) (string, bool) {
+	lowerCaseKey := strings.ToLower(key)
+	switch lowerCaseKey {
+	case "content-disposition":
+		return lowerCaseKey, true
+	default:
+		return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+	}
+}
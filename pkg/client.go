@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"crypto/sha256"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	argoprojv1alpha1 "github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
@@ -12,11 +13,13 @@ import (
 	"github.com/onepanelio/core/pkg/util/s3"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/yaml"
 	"strconv"
+	"sync"
 	"time"
 )
 
@@ -27,10 +30,13 @@ var sb = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 type Client struct {
 	Token string
 	kubernetes.Interface
+	config           *Config
 	argoprojV1alpha1 argoprojv1alpha1.ArgoprojV1alpha1Interface
 	*DB
 	systemConfig SystemConfig
 	cache        map[string]interface{}
+	// username is the user of the token, once resolved. Clients are created per request, so it is resolved once per request.
+	username *string
 }
 
 func (c *Client) ArgoprojV1alpha1() argoprojv1alpha1.ArgoprojV1alpha1Interface {
@@ -101,8 +107,12 @@ func NewClient(config *Config, db *DB, systemConfig SystemConfig) (client *Clien
 		return
 	}
 
+	// The config is copied because callers reuse it for clients with other tokens
+	clientConfig := *config
+
 	return &Client{
 		Interface:        kubeClient,
+		config:           &clientConfig,
 		argoprojV1alpha1: argoClient,
 		DB:               db,
 		systemConfig:     systemConfig,
@@ -110,6 +120,107 @@ func NewClient(config *Config, db *DB, systemConfig SystemConfig) (client *Clien
 	}, nil
 }
 
+const (
+	// usernameCacheTTL is how long the username of a token is cached before the token is reviewed again
+	usernameCacheTTL = 5 * time.Minute
+	// usernameCacheSize is the most tokens whose usernames are cached
+	usernameCacheSize = 1000
+)
+
+// cachedUsername is the username of a token and when it stops being cached
+type cachedUsername struct {
+	username  string
+	expiresAt time.Time
+}
+
+// usernames caches the username of each token, keyed by the sha256 of the token
+var usernames = struct {
+	sync.Mutex
+	entries map[string]cachedUsername
+}{
+	entries: make(map[string]cachedUsername),
+}
+
+// loadCachedUsername returns the cached username of the token key, if it has not expired at the time now
+func loadCachedUsername(key string, now time.Time) (string, bool) {
+	usernames.Lock()
+	defer usernames.Unlock()
+
+	entry, ok := usernames.entries[key]
+	if !ok || !now.Before(entry.expiresAt) {
+		return "", false
+	}
+
+	return entry.username, true
+}
+
+// storeCachedUsername caches the username of the token key from the time now.
+// When the cache is full, expired usernames are dropped and, if that is not enough, an arbitrary one is.
+func storeCachedUsername(key, username string, now time.Time) {
+	usernames.Lock()
+	defer usernames.Unlock()
+
+	if _, ok := usernames.entries[key]; !ok && len(usernames.entries) >= usernameCacheSize {
+		for k, entry := range usernames.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(usernames.entries, k)
+			}
+		}
+		for k := range usernames.entries {
+			if len(usernames.entries) < usernameCacheSize {
+				break
+			}
+			delete(usernames.entries, k)
+		}
+	}
+
+	usernames.entries[key] = cachedUsername{
+		username:  username,
+		expiresAt: now.Add(usernameCacheTTL),
+	}
+}
+
+// Username returns the name of the user the client authenticates as, e.g. system:serviceaccount:default:admin.
+// The token of the client is reviewed with the credentials of the API server, at most once every usernameCacheTTL.
+// An empty username is returned if the client has no token.
+func (c *Client) Username() (string, error) {
+	if c.Token == "" {
+		return "", nil
+	}
+	if c.username != nil {
+		return *c.username, nil
+	}
+
+	key := fmt.Sprintf("%x", sha256.Sum256([]byte(c.Token)))
+	if username, ok := loadCachedUsername(key, time.Now()); ok {
+		c.username = &username
+		return username, nil
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(NewConfig())
+	if err != nil {
+		return "", err
+	}
+
+	review, err := kubeClient.AuthenticationV1().TokenReviews().Create(&authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: c.Token,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	if !review.Status.Authenticated {
+		return "", util.NewUserError(codes.Unauthenticated, "Unauthenticated.")
+	}
+
+	username := review.Status.User.Username
+	storeCachedUsername(key, username, time.Now())
+	c.username = &username
+
+	return username, nil
+}
+
 // GetS3Client initializes a client to Amazon Cloud Storage.
 func (c *Client) GetS3Client(namespace string, config *ArtifactRepositoryS3Provider) (s3Client *s3.Client, err error) {
 	s3Client, err = s3.NewClient(s3.Config{
@@ -6,6 +6,7 @@ import (
 	argoFake "github.com/argoproj/argo/pkg/client/clientset/versioned/fake"
 	"github.com/jmoiron/sqlx"
 	"github.com/pressly/goose"
+	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -13,6 +14,7 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 )
 
 var (
@@ -100,8 +102,18 @@ func DefaultTestClient() *Client {
 func clearDatabase(t *testing.T) {
 	// We do not delete from goose_db_version as we need it to mark the migrations as ran.
 	query := `
+		DELETE FROM workspace_events;
+		DELETE FROM workspace_phase_transitions;
+		DELETE FROM workspace_collaborators;
+		DELETE FROM workspace_snapshots;
+		DELETE FROM workspace_schedules;
 		DELETE FROM workspaces;
+		DELETE FROM workflow_trigger_objects;
+		DELETE FROM workflow_triggers;
+		DELETE FROM workflow_execution_metrics;
 		DELETE FROM workflow_executions;
+		DELETE FROM cron_workflow_backfill_runs;
+		DELETE FROM cron_workflow_backfills;
 		DELETE FROM cron_workflows;
 		DELETE FROM workspace_templates;
 		DELETE FROM workflow_templates;
@@ -114,3 +126,21 @@ func clearDatabase(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+// Test_UsernameCache makes sure cached usernames expire and the cache does not grow past its size
+func Test_UsernameCache(t *testing.T) {
+	now := time.Now()
+
+	storeCachedUsername("token", "admin", now)
+	username, ok := loadCachedUsername("token", now.Add(usernameCacheTTL-time.Second))
+	assert.True(t, ok)
+	assert.Equal(t, "admin", username)
+
+	_, ok = loadCachedUsername("token", now.Add(usernameCacheTTL))
+	assert.False(t, ok)
+
+	for i := 0; i < usernameCacheSize+10; i++ {
+		storeCachedUsername(fmt.Sprintf("token-%v", i), "admin", now)
+	}
+	assert.Len(t, usernames.entries, usernameCacheSize)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/onepanelio/core/pkg/util/ptr"
 	"gopkg.in/yaml.v2"
+	"regexp"
 )
 
 // +genclient
@@ -23,10 +24,23 @@ type Parameter struct {
 	Hint        *string            `json:"hint,omitempty" protobuf:"bytes,5,opt,name=hint"`
 	Options     []*ParameterOption `json:"options,omitempty" protobuf:"bytes,6,opt,name=options"`
 	Required    bool               `json:"required,omitempty" protobuf:"bytes,7,opt,name=required"`
+	Min         *float64           `json:"min,omitempty"`
+	Max         *float64           `json:"max,omitempty"`
+	Pattern     *string            `json:"pattern,omitempty"`
 }
 
 // IsValidParameter returns nil if the parameter is valid or an error otherwise
 func IsValidParameter(parameter Parameter) error {
+	if parameter.Min != nil && parameter.Max != nil && *parameter.Min > *parameter.Max {
+		return fmt.Errorf("min is greater than max for parameter '%v'", parameter.Name)
+	}
+
+	if parameter.Pattern != nil {
+		if _, err := regexp.Compile(*parameter.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern for parameter '%v': %v", parameter.Name, err)
+		}
+	}
+
 	if parameter.Visibility == nil {
 		return nil
 	}
@@ -1,12 +1,15 @@
 package v1
 
 import (
+	"database/sql"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
 	argojson "github.com/argoproj/pkg/json"
 	"github.com/onepanelio/core/pkg/util"
 	"github.com/onepanelio/core/pkg/util/label"
+	"github.com/onepanelio/core/pkg/util/mapping"
 	"github.com/onepanelio/core/pkg/util/request/pagination"
 	uid2 "github.com/onepanelio/core/pkg/util/uid"
 	log "github.com/sirupsen/logrus"
@@ -18,6 +21,7 @@ import (
 func (c *Client) UpdateCronWorkflow(namespace string, uid string, cronWorkflow *CronWorkflow) (*CronWorkflow, error) {
 	err := c.cronWorkflowSelectBuilderNoColumns(namespace, cronWorkflow.WorkflowExecution.WorkflowTemplate.UID).
 		Columns("cw.id").
+		Where(sq.Eq{"cw.uid": uid}).
 		RunWith(c.DB).
 		QueryRow().
 		Scan(&cronWorkflow.ID)
@@ -92,7 +96,18 @@ func (c *Client) UpdateCronWorkflow(namespace string, uid string, cronWorkflow *
 
 	wf := workflows[0]
 	argoCronWorkflow.Spec.WorkflowSpec = wf.Spec
-	_, err = c.updateCronWorkflow(namespace, uid, &workflowTemplate.ID, &wf, &argoCronWorkflow, opts)
+
+	previousArgoCronWorkflow, err := c.ArgoprojV1alpha1().CronWorkflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("CronWorkflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "CronWorkflow not found.")
+	}
+
+	updatedArgoCronWorkflow, err := c.updateCronWorkflow(namespace, uid, &workflowTemplate.ID, &wf, &argoCronWorkflow, opts)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Namespace":    namespace,
@@ -107,18 +122,31 @@ func (c *Client) UpdateCronWorkflow(namespace string, uid string, cronWorkflow *
 
 	_, err = sb.Update("cron_workflows").
 		SetMap(sq.Eq{
-			"manifest": cronWorkflow.Manifest,
-			"labels":   cronWorkflow.Labels,
+			"manifest":                     cronWorkflow.Manifest,
+			"labels":                       cronWorkflow.Labels,
+			"workflow_template_version_id": workflowTemplate.WorkflowTemplateVersionID,
 		}).Where(sq.Eq{"id": cronWorkflow.ID}).
 		RunWith(c.DB).
 		Exec()
 	if err != nil {
+		// Argo is put back as it was, so it keeps matching the cron workflow in the database
+		previousArgoCronWorkflow.ResourceVersion = updatedArgoCronWorkflow.ResourceVersion
+		if _, undoErr := c.ArgoprojV1alpha1().CronWorkflows(namespace).Update(previousArgoCronWorkflow); undoErr != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Error":     undoErr.Error(),
+			}).Error("Unable to undo the update of the cron workflow in Argo.")
+		}
+
 		return nil, err
 	}
 
 	return cronWorkflow, nil
 }
 
+// CreateCronWorkflow creates a cron workflow running a version of a workflow template.
+// If cronWorkflow.Name is set, it is used instead of a generated name.
 func (c *Client) CreateCronWorkflow(namespace string, cronWorkflow *CronWorkflow) (*CronWorkflow, error) {
 	workflow := cronWorkflow.WorkflowExecution
 	workflowTemplate, err := c.GetWorkflowTemplate(namespace, workflow.WorkflowTemplate.UID, workflow.WorkflowTemplate.Version)
@@ -135,11 +163,15 @@ func (c *Client) CreateCronWorkflow(namespace string, cronWorkflow *CronWorkflow
 	opts := &WorkflowExecutionOptions{
 		Labels: make(map[string]string),
 	}
-	opts.GenerateName, err = uid2.GenerateUID(workflowTemplate.Name, 63)
-	if err != nil {
-		return nil, err
+	if cronWorkflow.Name != "" {
+		opts.Name = cronWorkflow.Name
+	} else {
+		opts.GenerateName, err = uid2.GenerateUID(workflowTemplate.Name, 63)
+		if err != nil {
+			return nil, err
+		}
+		opts.GenerateName += "-"
 	}
-	opts.GenerateName += "-"
 	for _, param := range workflow.Parameters {
 		opts.Parameters = append(opts.Parameters, Parameter{
 			Name:  param.Name,
@@ -308,16 +340,28 @@ func (c *Client) DeleteCronWorkflowLabel(namespace, name string, keysToDelete ..
 	return wf.Labels, nil
 }
 
+// cronWorkflowKeysetColumns are the columns cron workflows are ordered by, most recently created first
+var cronWorkflowKeysetColumns = []pagination.KeysetColumn{
+	{Column: "cw.created_at", Field: "created_at", Desc: true},
+	{Column: "cw.id", Field: "id", Desc: true},
+}
+
 // ListCronWorkflows selects all of the cron workflows for the given namespace and workflow template uid
 func (c *Client) ListCronWorkflows(namespace, workflowTemplateUID string, pagination *pagination.PaginationRequest) (cronWorkflows []*CronWorkflow, err error) {
-	sb := c.cronWorkflowSelectBuilder(namespace, workflowTemplateUID).
-		OrderBy("cw.created_at DESC")
-	sb = *pagination.ApplyToSelect(&sb)
+	sb := c.cronWorkflowSelectBuilder(namespace, workflowTemplateUID)
+	paginatedSb, err := pagination.ApplyKeysetToSelect(&sb, cronWorkflowKeysetColumns)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
 
-	if err := c.DB.Selectx(&cronWorkflows, sb); err != nil {
+	if err := c.DB.Selectx(&cronWorkflows, *paginatedSb); err != nil {
 		return nil, err
 	}
 
+	if err := pagination.SetNextContinue(cronWorkflowKeysetColumns, cronWorkflows); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
 	return
 }
 
@@ -491,6 +535,113 @@ func (c *Client) TerminateCronWorkflow(namespace, uid string) (err error) {
 	return
 }
 
+// getArgoCronWorkflow returns the cron workflow from the database and from Argo, or a NotFound error
+func (c *Client) getArgoCronWorkflow(namespace, uid string) (*CronWorkflow, *wfv1.CronWorkflow, error) {
+	cronWorkflow, err := c.selectCronWorkflowWithWorkflowTemplateVersion(namespace, uid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, util.NewUserError(codes.NotFound, "CronWorkflow not found.")
+		}
+		return nil, nil, err
+	}
+
+	argoCronWorkflow, err := c.ArgoprojV1alpha1().CronWorkflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("CronWorkflow not found.")
+		return nil, nil, util.NewUserError(codes.NotFound, "CronWorkflow not found.")
+	}
+
+	return cronWorkflow, argoCronWorkflow, nil
+}
+
+// setCronWorkflowSuspend sets whether the scheduled runs of the cron workflow are skipped, in Argo and in its manifest
+func (c *Client) setCronWorkflowSuspend(namespace, uid string, suspend bool) (*CronWorkflow, error) {
+	cronWorkflow, argoCronWorkflow, err := c.getArgoCronWorkflow(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := mapping.NewFromYamlString(cronWorkflow.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	manifest["suspend"] = suspend
+	manifestBytes, err := manifest.ToYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+	cronWorkflow.Manifest = string(manifestBytes)
+
+	previousSuspend := argoCronWorkflow.Spec.Suspend
+	argoCronWorkflow.Spec.Suspend = suspend
+	updatedArgoCronWorkflow, err := c.ArgoprojV1alpha1().CronWorkflows(namespace).Update(argoCronWorkflow)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = sb.Update("cron_workflows").
+		Set("manifest", cronWorkflow.Manifest).
+		Where(sq.Eq{"id": cronWorkflow.ID}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		// Argo is put back as it was, so it keeps matching the manifest in the database
+		updatedArgoCronWorkflow.Spec.Suspend = previousSuspend
+		if _, undoErr := c.ArgoprojV1alpha1().CronWorkflows(namespace).Update(updatedArgoCronWorkflow); undoErr != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Error":     undoErr.Error(),
+			}).Error("Unable to undo the suspend of the cron workflow in Argo.")
+		}
+
+		return nil, err
+	}
+
+	return cronWorkflow, nil
+}
+
+// SuspendCronWorkflow stops the cron workflow from running on its schedule until it is resumed
+func (c *Client) SuspendCronWorkflow(namespace, uid string) (*CronWorkflow, error) {
+	return c.setCronWorkflowSuspend(namespace, uid, true)
+}
+
+// ResumeCronWorkflow runs a suspended cron workflow on its schedule again. Runs missed while it was suspended are skipped.
+func (c *Client) ResumeCronWorkflow(namespace, uid string) (*CronWorkflow, error) {
+	return c.setCronWorkflowSuspend(namespace, uid, false)
+}
+
+// RunCronWorkflowNow starts a run of the cron workflow outside of its schedule, even if it is suspended.
+// The run is created from the workflow spec of the cron workflow, so it is recorded like a scheduled run.
+func (c *Client) RunCronWorkflowNow(namespace, uid string) (*WorkflowExecution, error) {
+	_, argoCronWorkflow, err := c.getArgoCronWorkflow(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Create(common.ConvertCronWorkflowToWorkflow(argoCronWorkflow))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to run CronWorkflow.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to run CronWorkflow.")
+	}
+
+	return &WorkflowExecution{
+		CreatedAt: wf.CreationTimestamp.UTC(),
+		UID:       wf.Name,
+		Name:      wf.Name,
+		Namespace: namespace,
+		Phase:     wf.Status.Phase,
+	}, nil
+}
+
 func (c *Client) ArchiveCronWorkflow(namespace, uid string) (err error) {
 	err = c.ArgoprojV1alpha1().CronWorkflows(namespace).Delete(uid, nil)
 	if err != nil {
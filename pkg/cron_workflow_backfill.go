@@ -0,0 +1,462 @@
+package v1
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	uid2 "github.com/onepanelio/core/pkg/util/uid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// cronWorkflowBackfillSelectBuilder selects the backfills with the counts of their runs
+func cronWorkflowBackfillSelectBuilder() sq.SelectBuilder {
+	return sb.Select(getCronWorkflowBackfillColumns("b")...).
+		Columns(
+			"(SELECT COUNT(*) FROM cron_workflow_backfill_runs r WHERE r.backfill_id = b.id) runs",
+			"(SELECT COUNT(r.launched_at) FROM cron_workflow_backfill_runs r WHERE r.backfill_id = b.id) launched_runs",
+			`(SELECT COUNT(*) FROM cron_workflow_backfill_runs r
+				JOIN workflow_executions we ON we.name = r.workflow_name AND we.namespace = cw.namespace
+				WHERE r.backfill_id = b.id AND we.phase = 'Succeeded') succeeded_runs`,
+			`(SELECT COUNT(*) FROM cron_workflow_backfill_runs r
+				JOIN workflow_executions we ON we.name = r.workflow_name AND we.namespace = cw.namespace
+				WHERE r.backfill_id = b.id AND we.phase IN ('Failed', 'Error')) failed_runs`,
+		).
+		From("cron_workflow_backfills b").
+		Join("cron_workflows cw ON cw.id = b.cron_workflow_id")
+}
+
+// getCronWorkflowBackfill returns the backfill of the cron workflow, or a NotFound error
+func (c *Client) getCronWorkflowBackfill(cronWorkflowID uint64, uid string) (*CronWorkflowBackfill, error) {
+	query := cronWorkflowBackfillSelectBuilder().
+		Where(sq.Eq{
+			"b.cron_workflow_id": cronWorkflowID,
+			"b.uid":              uid,
+		})
+
+	backfill := &CronWorkflowBackfill{}
+	if err := c.DB.Getx(backfill, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Backfill not found.")
+		}
+
+		return nil, err
+	}
+
+	return backfill, nil
+}
+
+// BackfillCronWorkflow runs the cron workflow for each time it was scheduled to run after start and up to end,
+// with at most parallelism runs running at the same time. The first runs are launched right away.
+func (c *Client) BackfillCronWorkflow(namespace, uid string, start, end time.Time, parallelism int) (*CronWorkflowBackfill, error) {
+	if !end.After(start) {
+		return nil, util.NewUserError(codes.InvalidArgument, "The end of the range must be after its start.")
+	}
+	if end.After(time.Now()) {
+		return nil, util.NewUserError(codes.InvalidArgument, "The end of the range must not be in the future.")
+	}
+	if parallelism == 0 {
+		parallelism = 1
+	}
+	if parallelism < 0 || parallelism > maxCronWorkflowBackfillParallelism {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Parallelism must be between 1 and %v.", maxCronWorkflowBackfillParallelism))
+	}
+
+	cronWorkflow, _, err := c.getArgoCronWorkflow(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := cronWorkflow.GetSchedule()
+	if err != nil {
+		return nil, err
+	}
+	scheduledTimes, err := schedule.RunTimesBetween(start, end, maxCronWorkflowBackfillRuns)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+	if len(scheduledTimes) == 0 {
+		return nil, util.NewUserError(codes.InvalidArgument, "The cron workflow was not scheduled to run in the range.")
+	}
+
+	backfillUID, err := uid2.GenerateUID("backfill-"+time.Now().UTC().Format("20060102-150405"), 30)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	backfill := &runningCronWorkflowBackfill{
+		CronWorkflowBackfill: CronWorkflowBackfill{
+			UID:            backfillUID,
+			CronWorkflowID: cronWorkflow.ID,
+			StartTime:      start.UTC(),
+			EndTime:        end.UTC(),
+			Parallelism:    parallelism,
+			Phase:          CronWorkflowBackfillRunning,
+		},
+		Namespace:        namespace,
+		CronWorkflowName: cronWorkflow.Name,
+	}
+	err = sb.Insert("cron_workflow_backfills").
+		SetMap(sq.Eq{
+			"uid":              backfill.UID,
+			"cron_workflow_id": backfill.CronWorkflowID,
+			"start_time":       backfill.StartTime,
+			"end_time":         backfill.EndTime,
+			"parallelism":      backfill.Parallelism,
+			"phase":            backfill.Phase,
+		}).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&backfill.ID)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, util.NewUserError(codes.AlreadyExists, "Backfill already exists.")
+		}
+
+		return nil, err
+	}
+
+	runsInsert := sb.Insert("cron_workflow_backfill_runs").
+		Columns("backfill_id", "scheduled_time")
+	for _, scheduledTime := range scheduledTimes {
+		runsInsert = runsInsert.Values(backfill.ID, scheduledTime)
+	}
+	if _, err := runsInsert.RunWith(tx).Exec(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if err := c.advanceCronWorkflowBackfill(backfill); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":    namespace,
+			"CronWorkflow": uid,
+			"Backfill":     backfill.UID,
+			"Error":        err.Error(),
+		}).Error("Unable to launch backfill runs.")
+	}
+
+	return c.getCronWorkflowBackfill(cronWorkflow.ID, backfill.UID)
+}
+
+// ListCronWorkflowBackfills returns the backfills of the cron workflow, newest first
+func (c *Client) ListCronWorkflowBackfills(namespace, uid string) (backfills []*CronWorkflowBackfill, err error) {
+	cronWorkflowID, err := c.getCronWorkflowID(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	query := cronWorkflowBackfillSelectBuilder().
+		Where(sq.Eq{
+			"b.cron_workflow_id": cronWorkflowID,
+		}).
+		OrderBy("b.created_at DESC", "b.id DESC")
+
+	backfills = make([]*CronWorkflowBackfill, 0)
+	err = c.DB.Selectx(&backfills, query)
+
+	return
+}
+
+// CancelCronWorkflowBackfill stops launching the runs of the backfill and terminates the ones that are running
+func (c *Client) CancelCronWorkflowBackfill(namespace, uid, backfillUID string) error {
+	cronWorkflow, err := c.selectCronWorkflowWithWorkflowTemplateVersion(namespace, uid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return util.NewUserError(codes.NotFound, "CronWorkflow not found.")
+		}
+		return err
+	}
+
+	backfill, err := c.getCronWorkflowBackfill(cronWorkflow.ID, backfillUID)
+	if err != nil {
+		return err
+	}
+	if backfill.Phase != CronWorkflowBackfillRunning {
+		return util.NewUserError(codes.FailedPrecondition, "Backfill is not running.")
+	}
+
+	if err := c.finishCronWorkflowBackfill(backfill.ID, CronWorkflowBackfillCancelled); err != nil {
+		return err
+	}
+
+	workflows, err := c.listCronWorkflowBackfillWorkflows(namespace, cronWorkflow.Name, backfill.UID)
+	if err != nil {
+		return err
+	}
+	for _, wf := range workflows {
+		if wf.Status.Fulfilled() {
+			continue
+		}
+
+		if err := c.TerminateWorkflowExecution(namespace, wf.Name); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Backfill":  backfill.UID,
+				"Workflow":  wf.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to terminate backfill run.")
+		}
+	}
+
+	return nil
+}
+
+// finishCronWorkflowBackfill moves the backfill from running to the phase
+func (c *Client) finishCronWorkflowBackfill(id uint64, phase CronWorkflowBackfillPhase) error {
+	return finishCronWorkflowBackfill(c.DB, id, phase)
+}
+
+// finishCronWorkflowBackfill moves the backfill from running to the phase with the runner
+func finishCronWorkflowBackfill(runner sq.BaseRunner, id uint64, phase CronWorkflowBackfillPhase) error {
+	_, err := sb.Update("cron_workflow_backfills").
+		SetMap(sq.Eq{
+			"phase":       phase,
+			"finished_at": time.Now().UTC(),
+		}).
+		Where(sq.Eq{
+			"id":    id,
+			"phase": CronWorkflowBackfillRunning,
+		}).
+		RunWith(runner).
+		Exec()
+
+	return err
+}
+
+// listCronWorkflowBackfillWorkflows returns the Argo workflows of the launched runs of the backfill that were not deleted
+func (c *Client) listCronWorkflowBackfillWorkflows(namespace, cronWorkflowName, backfillUID string) ([]wfv1.Workflow, error) {
+	workflows, err := c.ArgoprojV1alpha1().Workflows(namespace).List(metav1.ListOptions{
+		LabelSelector: cronWorkflowBackfillSelector(cronWorkflowName, backfillUID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return workflows.Items, nil
+}
+
+// advanceCronWorkflowBackfill launches the next runs of the backfill, up to its parallelism,
+// and completes it once all of its runs have finished.
+// The backfill is locked while it is advanced, so callers in other requests or replicas skip it instead of launching
+// more runs than its parallelism. Each run is claimed before it is launched, so it is never launched twice.
+func (c *Client) advanceCronWorkflowBackfill(backfill *runningCronWorkflowBackfill) error {
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	id := uint64(0)
+	err = sb.Select("id").
+		From("cron_workflow_backfills").
+		Where(sq.Eq{
+			"id":    backfill.ID,
+			"phase": CronWorkflowBackfillRunning,
+		}).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	workflows, err := c.listCronWorkflowBackfillWorkflows(backfill.Namespace, backfill.CronWorkflowName, backfill.UID)
+	if err != nil {
+		return err
+	}
+
+	running := 0
+	for _, wf := range workflows {
+		if !wf.Status.Fulfilled() {
+			running++
+		}
+	}
+
+	slots := backfill.Parallelism - running
+	if slots <= 0 {
+		return nil
+	}
+
+	query := sb.Select("id", "scheduled_time").
+		From("cron_workflow_backfill_runs").
+		Where(sq.Eq{
+			"backfill_id": backfill.ID,
+			"launched_at": nil,
+		}).
+		OrderBy("scheduled_time").
+		Limit(uint64(slots))
+
+	runs := make([]*cronWorkflowBackfillRun, 0)
+	if err := c.DB.Selectx(&runs, query); err != nil {
+		return err
+	}
+
+	if len(runs) == 0 {
+		if running == 0 {
+			if err := finishCronWorkflowBackfill(tx, backfill.ID, CronWorkflowBackfillCompleted); err != nil {
+				return err
+			}
+
+			return tx.Commit()
+		}
+
+		return nil
+	}
+
+	argoCronWorkflow, err := c.ArgoprojV1alpha1().CronWorkflows(backfill.Namespace).Get(backfill.CronWorkflowName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			if err := finishCronWorkflowBackfill(tx, backfill.ID, CronWorkflowBackfillFailed); err != nil {
+				return err
+			}
+
+			return tx.Commit()
+		}
+
+		return err
+	}
+
+	for _, run := range runs {
+		claimed, err := c.claimCronWorkflowBackfillRun(run.ID)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+
+		wf, err := c.ArgoprojV1alpha1().Workflows(backfill.Namespace).Create(newCronWorkflowBackfillWorkflow(argoCronWorkflow, backfill.UID, run.ScheduledTime))
+		if err != nil {
+			c.releaseCronWorkflowBackfillRun(run.ID)
+			return err
+		}
+
+		// The run is already claimed, so it is not launched again if its workflow name can not be recorded
+		_, err = sb.Update("cron_workflow_backfill_runs").
+			Set("workflow_name", wf.Name).
+			Where(sq.Eq{"id": run.ID}).
+			RunWith(c.DB).
+			Exec()
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": backfill.Namespace,
+				"Backfill":  backfill.UID,
+				"Workflow":  wf.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to record the workflow of a backfill run.")
+		}
+	}
+
+	return nil
+}
+
+// claimCronWorkflowBackfillRun marks the run as launched if it has not been launched yet.
+// It returns false if the run was already claimed.
+func (c *Client) claimCronWorkflowBackfillRun(id uint64) (claimed bool, err error) {
+	result, err := sb.Update("cron_workflow_backfill_runs").
+		Set("launched_at", time.Now().UTC()).
+		Where(sq.Eq{
+			"id":          id,
+			"launched_at": nil,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// releaseCronWorkflowBackfillRun marks a claimed run that could not be launched as not launched, so it is launched later
+func (c *Client) releaseCronWorkflowBackfillRun(id uint64) {
+	_, err := sb.Update("cron_workflow_backfill_runs").
+		Set("launched_at", nil).
+		Where(sq.Eq{
+			"id":            id,
+			"workflow_name": nil,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Run":   id,
+			"Error": err.Error(),
+		}).Error("Unable to release a backfill run.")
+	}
+}
+
+// AdvanceCronWorkflowBackfills launches the next runs of the running backfills and completes the finished ones
+func (c *Client) AdvanceCronWorkflowBackfills() error {
+	query := sb.Select(getCronWorkflowBackfillColumns("b")...).
+		Columns("cw.namespace", `cw.name "cron_workflow_name"`).
+		From("cron_workflow_backfills b").
+		Join("cron_workflows cw ON cw.id = b.cron_workflow_id").
+		Where(sq.Eq{
+			"b.phase": CronWorkflowBackfillRunning,
+		}).
+		OrderBy("b.created_at")
+
+	backfills := make([]*runningCronWorkflowBackfill, 0)
+	if err := c.DB.Selectx(&backfills, query); err != nil {
+		return err
+	}
+
+	for _, backfill := range backfills {
+		if err := c.advanceCronWorkflowBackfill(backfill); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":    backfill.Namespace,
+				"CronWorkflow": backfill.CronWorkflowName,
+				"Backfill":     backfill.UID,
+				"Error":        err.Error(),
+			}).Error("Unable to advance backfill.")
+		}
+	}
+
+	return nil
+}
+
+// RunCronWorkflowBackfillController advances the running backfills every interval until stopCh is closed
+func (c *Client) RunCronWorkflowBackfillController(interval time.Duration, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if err := c.AdvanceCronWorkflowBackfills(); err != nil {
+				log.WithFields(log.Fields{
+					"Error": err.Error(),
+				}).Error("Unable to advance cron workflow backfills.")
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/onepanelio/core/pkg/util/sql"
+)
+
+// CronWorkflowBackfillPhase is the state of a CronWorkflowBackfill
+type CronWorkflowBackfillPhase string
+
+const (
+	// CronWorkflowBackfillRunning is a backfill with runs that are not launched or have not finished
+	CronWorkflowBackfillRunning CronWorkflowBackfillPhase = "Running"
+	// CronWorkflowBackfillCompleted is a backfill whose runs have all finished
+	CronWorkflowBackfillCompleted CronWorkflowBackfillPhase = "Completed"
+	// CronWorkflowBackfillCancelled is a backfill that was cancelled before its runs finished
+	CronWorkflowBackfillCancelled CronWorkflowBackfillPhase = "Cancelled"
+	// CronWorkflowBackfillFailed is a backfill whose runs could not be launched, e.g. because the cron workflow was deleted
+	CronWorkflowBackfillFailed CronWorkflowBackfillPhase = "Failed"
+)
+
+const (
+	// CronWorkflowScheduledTimeParameter is the parameter with the time, in RFC3339, a run of a backfill is for.
+	// Templates can use it as {{workflow.parameters.sys-scheduled-time}}
+	CronWorkflowScheduledTimeParameter = "sys-scheduled-time"
+	// cronWorkflowBackfillLabelKey labels the workflows of a backfill with its uid
+	cronWorkflowBackfillLabelKey = "onepanel.io/cron-workflow-backfill"
+	// maxCronWorkflowBackfillRuns is the maximum number of runs of a backfill
+	maxCronWorkflowBackfillRuns = 1000
+	// maxCronWorkflowBackfillParallelism is the maximum number of runs of a backfill that run at the same time
+	maxCronWorkflowBackfillParallelism = 10
+)
+
+// CronWorkflowBackfill is a group of runs of a CronWorkflow for the times it was scheduled to run between StartTime and EndTime.
+// At most Parallelism runs are running at the same time.
+type CronWorkflowBackfill struct {
+	ID             uint64
+	UID            string
+	CronWorkflowID uint64    `db:"cron_workflow_id"`
+	StartTime      time.Time `db:"start_time"`
+	EndTime        time.Time `db:"end_time"`
+	Parallelism    int
+	Phase          CronWorkflowBackfillPhase
+	FinishedAt     *time.Time `db:"finished_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	// Runs is the number of runs, LaunchedRuns the number of those that were launched,
+	// and SucceededRuns and FailedRuns the number of those that finished
+	Runs          int `db:"runs"`
+	LaunchedRuns  int `db:"launched_runs"`
+	SucceededRuns int `db:"succeeded_runs"`
+	FailedRuns    int `db:"failed_runs"`
+}
+
+// runningCronWorkflowBackfill is a running backfill with the cron workflow it runs
+type runningCronWorkflowBackfill struct {
+	CronWorkflowBackfill
+	Namespace        string
+	CronWorkflowName string `db:"cron_workflow_name"`
+}
+
+// cronWorkflowBackfillRun is a run of a backfill that is not launched yet
+type cronWorkflowBackfillRun struct {
+	ID            uint64
+	ScheduledTime time.Time `db:"scheduled_time"`
+}
+
+// getCronWorkflowBackfillColumns returns all of the columns for cron_workflow_backfills modified by alias, destination.
+// see formatColumnSelect
+func getCronWorkflowBackfillColumns(aliasAndDestination ...string) []string {
+	columns := []string{
+		"id",
+		"uid",
+		"cron_workflow_id",
+		"start_time",
+		"end_time",
+		"parallelism",
+		"phase",
+		"finished_at",
+		"created_at",
+	}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// cronWorkflowBackfillSelector returns the label selector of the workflows of the backfill
+func cronWorkflowBackfillSelector(cronWorkflowName, backfillUID string) string {
+	return common.LabelKeyCronWorkflow + "=" + cronWorkflowName + "," + cronWorkflowBackfillLabelKey + "=" + backfillUID
+}
+
+// newCronWorkflowBackfillWorkflow returns the workflow of the run of the backfill for scheduledTime.
+// It is the workflow Argo runs on the schedule, with the scheduled time as a parameter.
+func newCronWorkflowBackfillWorkflow(cronWorkflow *wfv1.CronWorkflow, backfillUID string, scheduledTime time.Time) *wfv1.Workflow {
+	wf := common.ConvertCronWorkflowToWorkflow(cronWorkflow)
+	wf.Labels[cronWorkflowBackfillLabelKey] = backfillUID
+
+	parameters := make([]wfv1.Parameter, 0)
+	for _, parameter := range wf.Spec.Arguments.Parameters {
+		if parameter.Name != CronWorkflowScheduledTimeParameter {
+			parameters = append(parameters, parameter)
+		}
+	}
+	parameters = append(parameters, wfv1.Parameter{
+		Name:  CronWorkflowScheduledTimeParameter,
+		Value: wfv1.AnyStringPtr(scheduledTime.UTC().Format(time.RFC3339)),
+	})
+	wf.Spec.Arguments.Parameters = parameters
+
+	return wf
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/common"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_newCronWorkflowBackfillWorkflow(t *testing.T) {
+	cronWorkflow := &wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "data-prep",
+		},
+		Spec: wfv1.CronWorkflowSpec{
+			WorkflowSpec: wfv1.WorkflowSpec{
+				Entrypoint: "main",
+				Arguments: wfv1.Arguments{
+					Parameters: []wfv1.Parameter{
+						{Name: "source", Value: wfv1.AnyStringPtr("s3://data")},
+						{Name: CronWorkflowScheduledTimeParameter, Value: wfv1.AnyStringPtr("")},
+					},
+				},
+			},
+		},
+	}
+
+	scheduledTime := time.Date(2021, 12, 4, 2, 0, 0, 0, time.UTC)
+	wf := newCronWorkflowBackfillWorkflow(cronWorkflow, "backfill-20211206-090000", scheduledTime)
+
+	assert.Equal(t, "data-prep-", wf.GenerateName)
+	assert.Equal(t, "data-prep", wf.Labels[common.LabelKeyCronWorkflow])
+	assert.Equal(t, "backfill-20211206-090000", wf.Labels[cronWorkflowBackfillLabelKey])
+	assert.Len(t, wf.Spec.Arguments.Parameters, 2)
+	assert.Equal(t, "source", wf.Spec.Arguments.Parameters[0].Name)
+	assert.Equal(t, CronWorkflowScheduledTimeParameter, wf.Spec.Arguments.Parameters[1].Name)
+	assert.Equal(t, "2021-12-04T02:00:00Z", wf.Spec.Arguments.Parameters[1].Value.String())
+
+	// The cron workflow is not changed
+	assert.Equal(t, "", cronWorkflow.Spec.WorkflowSpec.Arguments.Parameters[1].Value.String())
+}
@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"google.golang.org/grpc/codes"
+)
+
+// getCronWorkflowID returns the id of the cron workflow that is not archived, or a NotFound error
+func (c *Client) getCronWorkflowID(namespace, uid string) (uint64, error) {
+	cronWorkflow, err := c.selectCronWorkflowWithWorkflowTemplateVersion(namespace, uid)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, util.NewUserError(codes.NotFound, "CronWorkflow not found.")
+		}
+
+		return 0, err
+	}
+
+	return cronWorkflow.ID, nil
+}
+
+// cronWorkflowRunsSelectBuilder selects the workflow executions started by the cron workflow that are not archived
+func cronWorkflowRunsSelectBuilder(cronWorkflowID uint64) sq.SelectBuilder {
+	return sb.Select(getWorkflowExecutionColumns("we")...).
+		From("workflow_executions we").
+		Where(sq.Eq{
+			"we.cron_workflow_id": cronWorkflowID,
+			"we.is_archived":      false,
+		}).
+		OrderBy("we.created_at DESC", "we.id DESC")
+}
+
+// ListCronWorkflowRuns returns the workflow executions started by the cron workflow, newest first
+func (c *Client) ListCronWorkflowRuns(namespace, uid string, paginator *pagination.PaginationRequest) (runs []*WorkflowExecution, err error) {
+	cronWorkflowID, err := c.getCronWorkflowID(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	query := cronWorkflowRunsSelectBuilder(cronWorkflowID)
+
+	runs = make([]*WorkflowExecution, 0)
+	err = c.DB.Selectx(&runs, *paginator.ApplyToSelect(&query))
+
+	return
+}
+
+// CountCronWorkflowRuns returns the number of workflow executions started by the cron workflow that are not archived
+func (c *Client) CountCronWorkflowRuns(namespace, uid string) (count int, err error) {
+	cronWorkflowID, err := c.getCronWorkflowID(namespace, uid)
+	if err != nil {
+		return 0, err
+	}
+
+	err = sb.Select("COUNT(*)").
+		From("workflow_executions we").
+		Where(sq.Eq{
+			"we.cron_workflow_id": cronWorkflowID,
+			"we.is_archived":      false,
+		}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
+
+// getLastCronWorkflowRun returns the most recent run of the cron workflow in one of the phases, or nil if there is none
+func (c *Client) getLastCronWorkflowRun(cronWorkflowID uint64, phases ...wfv1.NodePhase) (*WorkflowExecution, error) {
+	query := cronWorkflowRunsSelectBuilder(cronWorkflowID).
+		Where(sq.Eq{
+			"we.phase": phases,
+		}).
+		Limit(1)
+
+	run := &WorkflowExecution{}
+	if err := c.DB.Getx(run, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// GetCronWorkflowRunSummary returns the most recent successful and failed runs of the cron workflow
+func (c *Client) GetCronWorkflowRunSummary(namespace, uid string) (summary *CronWorkflowRunSummary, err error) {
+	cronWorkflowID, err := c.getCronWorkflowID(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	summary = &CronWorkflowRunSummary{}
+	summary.LastSuccess, err = c.getLastCronWorkflowRun(cronWorkflowID, wfv1.NodeSucceeded)
+	if err != nil {
+		return nil, err
+	}
+
+	summary.LastFailure, err = c.getLastCronWorkflowRun(cronWorkflowID, wfv1.NodeFailed, wfv1.NodeError)
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// cronWorkflowRunSummaryRow is the most recent run of a cron workflow in a phase, see ListCronWorkflowRunSummaries
+type cronWorkflowRunSummaryRow struct {
+	WorkflowExecution
+	CronWorkflowID uint64 `db:"cron_workflow_id"`
+}
+
+// cronWorkflowRunSummaries groups the most recent runs of the cron workflows into a summary per cron workflow id.
+// Failed and errored runs are both failures, so the most recent of them is kept.
+func cronWorkflowRunSummaries(rows []*cronWorkflowRunSummaryRow) map[uint64]*CronWorkflowRunSummary {
+	summaries := make(map[uint64]*CronWorkflowRunSummary)
+	for _, row := range rows {
+		summary, ok := summaries[row.CronWorkflowID]
+		if !ok {
+			summary = &CronWorkflowRunSummary{}
+			summaries[row.CronWorkflowID] = summary
+		}
+
+		run := row.WorkflowExecution
+		if run.Phase == wfv1.NodeSucceeded {
+			summary.LastSuccess = &run
+		} else if summary.LastFailure == nil || run.CreatedAt.After(summary.LastFailure.CreatedAt) {
+			summary.LastFailure = &run
+		}
+	}
+
+	return summaries
+}
+
+// ListCronWorkflowRunSummaries returns the most recent successful and failed runs of the cron workflows, by their id,
+// with a single query. Cron workflows without runs are not in the result.
+func (c *Client) ListCronWorkflowRunSummaries(cronWorkflowIDs []uint64) (map[uint64]*CronWorkflowRunSummary, error) {
+	if len(cronWorkflowIDs) == 0 {
+		return make(map[uint64]*CronWorkflowRunSummary), nil
+	}
+
+	query := sb.Select(getWorkflowExecutionColumns("we")...).
+		Columns("we.cron_workflow_id").
+		Options("DISTINCT ON (we.cron_workflow_id, we.phase)").
+		From("workflow_executions we").
+		Where(sq.Eq{
+			"we.cron_workflow_id": cronWorkflowIDs,
+			"we.is_archived":      false,
+			"we.phase":            []wfv1.NodePhase{wfv1.NodeSucceeded, wfv1.NodeFailed, wfv1.NodeError},
+		}).
+		OrderBy("we.cron_workflow_id", "we.phase", "we.created_at DESC", "we.id DESC")
+
+	rows := make([]*cronWorkflowRunSummaryRow, 0)
+	if err := c.DB.Selectx(&rows, query); err != nil {
+		return nil, err
+	}
+
+	return cronWorkflowRunSummaries(rows), nil
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+// Test_cronWorkflowRunSummaries makes sure the runs are grouped by cron workflow and the latest failure or error is kept
+func Test_cronWorkflowRunSummaries(t *testing.T) {
+	now := time.Date(2021, 12, 9, 0, 0, 0, 0, time.UTC)
+	row := func(cronWorkflowID uint64, name string, phase wfv1.NodePhase, createdAt time.Time) *cronWorkflowRunSummaryRow {
+		return &cronWorkflowRunSummaryRow{
+			WorkflowExecution: WorkflowExecution{Name: name, Phase: phase, CreatedAt: createdAt},
+			CronWorkflowID:    cronWorkflowID,
+		}
+	}
+
+	summaries := cronWorkflowRunSummaries([]*cronWorkflowRunSummaryRow{
+		row(1, "errored", wfv1.NodeError, now.Add(-time.Hour)),
+		row(1, "failed", wfv1.NodeFailed, now),
+		row(1, "succeeded", wfv1.NodeSucceeded, now.Add(-2*time.Hour)),
+		row(2, "errored", wfv1.NodeError, now),
+	})
+
+	assert.Len(t, summaries, 2)
+	assert.Equal(t, "succeeded", summaries[1].LastSuccess.Name)
+	assert.Equal(t, "failed", summaries[1].LastFailure.Name)
+	assert.Nil(t, summaries[2].LastSuccess)
+	assert.Equal(t, "errored", summaries[2].LastFailure.Name)
+}
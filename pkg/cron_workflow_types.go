@@ -2,9 +2,11 @@ package v1
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/onepanelio/core/pkg/util/mapping"
 	"github.com/onepanelio/core/pkg/util/sql"
 	"github.com/onepanelio/core/pkg/util/types"
+	"github.com/robfig/cron/v3"
 	"gopkg.in/yaml.v2"
 	"time"
 )
@@ -31,6 +33,95 @@ type CronWorkflowManifest struct {
 	WorkflowExecutionSpec WorkflowExecutionSpec `json:"workflowSpec" yaml:"workflowSpec"`
 }
 
+// CronWorkflowSchedule is when a CronWorkflow runs, as set in its manifest
+type CronWorkflowSchedule struct {
+	Schedule string `json:"schedule" yaml:"schedule"`
+	Timezone string `json:"timezone" yaml:"timezone"`
+	Suspend  bool   `json:"suspend" yaml:"suspend"`
+}
+
+// CronWorkflowRunSummary is the most recent successful and failed runs of a CronWorkflow.
+// A run is nil if the CronWorkflow has none.
+type CronWorkflowRunSummary struct {
+	LastSuccess *WorkflowExecution
+	LastFailure *WorkflowExecution
+}
+
+// GetSchedule parses the schedule from the CronWorkflow's manifest
+func (cw *CronWorkflow) GetSchedule() (*CronWorkflowSchedule, error) {
+	schedule := &CronWorkflowSchedule{}
+	if err := yaml.Unmarshal([]byte(cw.Manifest), schedule); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// parse returns the parsed schedule and its location.
+// An empty timezone is the timezone of the server, as it is for Argo.
+func (s *CronWorkflowSchedule) parse() (cron.Schedule, *time.Location, error) {
+	schedule, err := cron.ParseStandard(s.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule '%v': %v", s.Schedule, err)
+	}
+
+	location := time.Local
+	if s.Timezone != "" {
+		location, err = time.LoadLocation(s.Timezone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid timezone '%v'", s.Timezone)
+		}
+	}
+
+	return schedule, location, nil
+}
+
+// NextRunTimes returns the next count times after after, in UTC, that the schedule runs.
+// A suspended schedule does not run.
+func (s *CronWorkflowSchedule) NextRunTimes(after time.Time, count int) ([]time.Time, error) {
+	schedule, location, err := s.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]time.Time, 0, count)
+	if s.Suspend {
+		return result, nil
+	}
+
+	next := after.In(location)
+	for i := 0; i < count; i++ {
+		next = schedule.Next(next)
+		if next.IsZero() {
+			break
+		}
+
+		result = append(result, next.UTC())
+	}
+
+	return result, nil
+}
+
+// RunTimesBetween returns the times after start and up to end, in UTC, that the schedule runs, whether it is suspended or not.
+// It returns an error if there are more than max.
+func (s *CronWorkflowSchedule) RunTimesBetween(start, end time.Time, max int) ([]time.Time, error) {
+	schedule, location, err := s.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]time.Time, 0)
+	for next := schedule.Next(start.In(location)); !next.IsZero() && !next.After(end); next = schedule.Next(next) {
+		if len(result) == max {
+			return nil, fmt.Errorf("there are more than %v runs between %v and %v", max, start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+		}
+
+		result = append(result, next.UTC())
+	}
+
+	return result, nil
+}
+
 // GetParametersFromWorkflowSpec parses the parameters from the CronWorkflow's manifest
 func (cw *CronWorkflow) GetParametersFromWorkflowSpec() ([]Parameter, error) {
 	manifestSpec := &CronWorkflowManifest{}
@@ -3,6 +3,7 @@ package v1
 import (
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 // TestCronWorkflow_GetParametersFromWorkflowSpec makes sure the GetParametersFromWorkflowSpec method works
@@ -111,3 +112,76 @@ workflowSpec:
 
 	assert.Len(t, parameters, 11)
 }
+
+// TestCronWorkflow_GetSchedule makes sure the schedule and timezone are parsed from the manifest
+func TestCronWorkflow_GetSchedule(t *testing.T) {
+	cronWorkflow := &CronWorkflow{
+		Manifest: `schedule: '0 9 * * 1-5'
+suspend: false
+timezone: America/New_York
+workflowSpec:
+  entrypoint: main
+`,
+	}
+
+	schedule, err := cronWorkflow.GetSchedule()
+	assert.Nil(t, err)
+	assert.Equal(t, "0 9 * * 1-5", schedule.Schedule)
+	assert.Equal(t, "America/New_York", schedule.Timezone)
+	assert.False(t, schedule.Suspend)
+}
+
+// TestCronWorkflowSchedule_NextRunTimes makes sure the next run times are computed in the timezone of the schedule
+func TestCronWorkflowSchedule_NextRunTimes(t *testing.T) {
+	schedule := &CronWorkflowSchedule{
+		Schedule: "0 9 * * 1-5",
+		Timezone: "America/New_York",
+	}
+
+	// Friday, 2021-12-03 12:00 UTC
+	after := time.Date(2021, 12, 3, 12, 0, 0, 0, time.UTC)
+	times, err := schedule.NextRunTimes(after, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2021, 12, 3, 14, 0, 0, 0, time.UTC),
+		time.Date(2021, 12, 6, 14, 0, 0, 0, time.UTC),
+		time.Date(2021, 12, 7, 14, 0, 0, 0, time.UTC),
+	}, times)
+
+	schedule.Suspend = true
+	times, err = schedule.NextRunTimes(after, 3)
+	assert.Nil(t, err)
+	assert.Len(t, times, 0)
+
+	schedule.Suspend = false
+	schedule.Timezone = "Not/AZone"
+	_, err = schedule.NextRunTimes(after, 3)
+	assert.NotNil(t, err)
+
+	schedule = &CronWorkflowSchedule{Schedule: "not a schedule"}
+	_, err = schedule.NextRunTimes(after, 3)
+	assert.NotNil(t, err)
+}
+
+// TestCronWorkflowSchedule_RunTimesBetween makes sure the missed runs of a range are computed, up to a maximum
+func TestCronWorkflowSchedule_RunTimesBetween(t *testing.T) {
+	schedule := &CronWorkflowSchedule{
+		Schedule: "0 2 * * *",
+		Timezone: "UTC",
+		Suspend:  true,
+	}
+
+	// Friday 2021-12-03 18:00 to Monday 2021-12-06 02:00
+	start := time.Date(2021, 12, 3, 18, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 12, 6, 2, 0, 0, 0, time.UTC)
+	times, err := schedule.RunTimesBetween(start, end, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2021, 12, 4, 2, 0, 0, 0, time.UTC),
+		time.Date(2021, 12, 5, 2, 0, 0, 0, time.UTC),
+		time.Date(2021, 12, 6, 2, 0, 0, 0, time.UTC),
+	}, times)
+
+	_, err = schedule.RunTimesBetween(start, end, 2)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,150 @@
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
+)
+
+// Parameter types whose values are validated and coerced
+const (
+	ParameterTypeNumber   = "input.number"
+	ParameterTypeCheckbox = "input.checkbox"
+)
+
+// parameterTypeHasAllowedValues returns true if values of the parameter type must be one of its options
+func parameterTypeHasAllowedValues(parameterType string) bool {
+	return strings.HasPrefix(parameterType, "select.") || strings.HasPrefix(parameterType, "radio.")
+}
+
+// formatParameterNumber formats a number value without trailing zeros, e.g. 10 instead of 10.0
+func formatParameterNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// validateParameterValue checks value against the schema of a template parameter.
+// It returns the value coerced to the type of the parameter, or a description of why it is invalid.
+func validateParameterValue(schema *Parameter, value string) (string, string) {
+	switch schema.Type {
+	case ParameterTypeNumber:
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return "", "must be a number"
+		}
+		if schema.Min != nil && number < *schema.Min {
+			return "", fmt.Sprintf("must be at least %v", formatParameterNumber(*schema.Min))
+		}
+		if schema.Max != nil && number > *schema.Max {
+			return "", fmt.Sprintf("must be at most %v", formatParameterNumber(*schema.Max))
+		}
+
+		return formatParameterNumber(number), ""
+	case ParameterTypeCheckbox:
+		checked, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return "", "must be true or false"
+		}
+
+		return strconv.FormatBool(checked), ""
+	}
+
+	if schema.Pattern != nil {
+		pattern, err := regexp.Compile("^(?:" + *schema.Pattern + ")$")
+		if err != nil {
+			return "", "has an invalid pattern"
+		}
+		if !pattern.MatchString(value) {
+			return "", fmt.Sprintf("must match the pattern %v", *schema.Pattern)
+		}
+	}
+
+	if parameterTypeHasAllowedValues(schema.Type) && len(schema.Options) != 0 {
+		allowed := make([]string, 0)
+		for _, option := range schema.Options {
+			if option.Value == value {
+				return value, ""
+			}
+			allowed = append(allowed, option.Value)
+		}
+
+		return "", fmt.Sprintf("must be one of: %v", strings.Join(allowed, ", "))
+	}
+
+	return value, ""
+}
+
+// ValidateParameters checks the values of parameters against the parameters of a template, the schemas.
+// Required parameters must have a value, either given or a default from the schema.
+// Values are coerced to the type of their parameter, e.g. "1.50" becomes "1.5" for input.number, and are updated in place.
+// Parameters that are not in the schemas are not checked.
+// If any value is invalid, an InvalidArgument error with a field violation per parameter is returned.
+func ValidateParameters(schemas []Parameter, parameters []Parameter) error {
+	parametersByName := make(map[string]*Parameter)
+	for i := range parameters {
+		parametersByName[parameters[i].Name] = &parameters[i]
+	}
+
+	violations := make([]*util.FieldViolation, 0)
+	for i := range schemas {
+		schema := &schemas[i]
+
+		// Without a value, the default of the template is used
+		value := schema.Value
+		parameter, ok := parametersByName[schema.Name]
+		if ok && parameter.Value != nil {
+			value = parameter.Value
+		}
+
+		if value == nil || *value == "" {
+			if schema.Required {
+				violations = append(violations, &util.FieldViolation{
+					Field:       "parameters." + schema.Name,
+					Description: "is required",
+				})
+			}
+			continue
+		}
+
+		if !ok || parameter.Value == nil {
+			continue
+		}
+
+		coerced, violation := validateParameterValue(schema, *parameter.Value)
+		if violation != "" {
+			violations = append(violations, &util.FieldViolation{
+				Field:       "parameters." + schema.Name,
+				Description: violation,
+			})
+			continue
+		}
+
+		parameter.Value = ptr.String(coerced)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(violations))
+	for i, violation := range violations {
+		messages[i] = fmt.Sprintf("%v %v", strings.TrimPrefix(violation.Field, "parameters."), violation.Description)
+	}
+
+	return util.NewFieldViolationsError("Invalid parameters: "+strings.Join(messages, "; ")+".", violations)
+}
+
+// ValidateManifestParameters checks the values of parameters against the parameters declared in a template manifest.
+// See ValidateParameters. It is called by the API handlers, on the values sent by users, and by triggers, on the values
+// of their events. Internal launches like cron runs and backfills keep working with the values they were created with.
+func ValidateManifestParameters(manifest string, parameters []Parameter) error {
+	schemas, err := ParseParametersFromManifest([]byte(manifest))
+	if err != nil {
+		return err
+	}
+
+	return ValidateParameters(schemas, parameters)
+}
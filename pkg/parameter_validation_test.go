@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+func testParameterFloat(value float64) *float64 {
+	return &value
+}
+
+func Test_ValidateParameters_Coercion(t *testing.T) {
+	schemas := []Parameter{
+		{Name: "epochs", Type: ParameterTypeNumber, Min: testParameterFloat(1), Max: testParameterFloat(100)},
+		{Name: "augment", Type: ParameterTypeCheckbox},
+		{Name: "model", Type: "select.select", Options: []*ParameterOption{{Name: "ResNet", Value: "resnet"}, {Name: "VGG", Value: "vgg"}}},
+		{Name: "bucket", Type: "input.text", Pattern: ptr.String("[a-z0-9-]+")},
+	}
+	parameters := []Parameter{
+		{Name: "epochs", Value: ptr.String(" 10.0 ")},
+		{Name: "augment", Value: ptr.String("1")},
+		{Name: "model", Value: ptr.String("vgg")},
+		{Name: "bucket", Value: ptr.String("my-bucket")},
+		{Name: "sys-host", Value: ptr.String("anything")},
+	}
+
+	err := ValidateParameters(schemas, parameters)
+	assert.Nil(t, err)
+	assert.Equal(t, "10", *parameters[0].Value)
+	assert.Equal(t, "true", *parameters[1].Value)
+	assert.Equal(t, "vgg", *parameters[2].Value)
+	assert.Equal(t, "my-bucket", *parameters[3].Value)
+}
+
+func Test_ValidateParameters_Violations(t *testing.T) {
+	schemas := []Parameter{
+		{Name: "epochs", Type: ParameterTypeNumber, Max: testParameterFloat(100)},
+		{Name: "rate", Type: ParameterTypeNumber},
+		{Name: "augment", Type: ParameterTypeCheckbox},
+		{Name: "model", Type: "select.select", Options: []*ParameterOption{{Name: "ResNet", Value: "resnet"}}},
+		{Name: "bucket", Type: "input.text", Pattern: ptr.String("[a-z]+")},
+		{Name: "dataset", Type: "input.text", Required: true},
+		{Name: "region", Type: "input.text", Required: true, Value: ptr.String("us-west-2")},
+	}
+	parameters := []Parameter{
+		{Name: "epochs", Value: ptr.String("101")},
+		{Name: "rate", Value: ptr.String("fast")},
+		{Name: "augment", Value: ptr.String("maybe")},
+		{Name: "model", Value: ptr.String("vgg")},
+		{Name: "bucket", Value: ptr.String("Bucket1")},
+	}
+
+	err := ValidateParameters(schemas, parameters)
+	assert.NotNil(t, err)
+
+	userErr, ok := err.(*util.UserError)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, userErr.Code)
+	assert.Equal(t, []*util.FieldViolation{
+		{Field: "parameters.epochs", Description: "must be at most 100"},
+		{Field: "parameters.rate", Description: "must be a number"},
+		{Field: "parameters.augment", Description: "must be true or false"},
+		{Field: "parameters.model", Description: "must be one of: resnet"},
+		{Field: "parameters.bucket", Description: "must match the pattern [a-z]+"},
+		{Field: "parameters.dataset", Description: "is required"},
+	}, userErr.Violations)
+	assert.Len(t, userErr.GRPCStatus().Details(), 1)
+}
+
+func Test_IsValidParameter_Constraints(t *testing.T) {
+	err := IsValidParameter(Parameter{Name: "epochs", Min: testParameterFloat(10), Max: testParameterFloat(1)})
+	assert.NotNil(t, err)
+
+	err = IsValidParameter(Parameter{Name: "bucket", Pattern: ptr.String("[a-z")})
+	assert.NotNil(t, err)
+}
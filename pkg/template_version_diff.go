@@ -0,0 +1,125 @@
+package v1
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/extensions"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v3"
+)
+
+// diffTemplateManifests compares the manifests of two template versions
+func diffTemplateManifests(from, to *templateManifestVersion) (*TemplateVersionDiff, error) {
+	fromNode := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(from.Manifest), fromNode); err != nil {
+		return nil, err
+	}
+
+	toNode := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(to.Manifest), toNode); err != nil {
+		return nil, err
+	}
+
+	changes, err := extensions.Diff(fromNode, toNode)
+	if err != nil {
+		return nil, err
+	}
+
+	fromParameters, err := ParseParametersFromManifest([]byte(from.Manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	toParameters, err := ParseParametersFromManifest([]byte(to.Manifest))
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateVersionDiff{
+		FromVersion:      from.Version,
+		ToVersion:        to.Version,
+		Changes:          changes,
+		ParameterChanges: diffParameters(fromParameters, toParameters),
+	}, nil
+}
+
+// getTemplateManifestVersion runs a query selecting a template version and its manifest.
+// A NotFound error with the message notFound is returned if there is no such version.
+func (c *Client) getTemplateManifestVersion(query sq.SelectBuilder, version int64, notFound string) (*templateManifestVersion, error) {
+	if version == 0 {
+		query = query.Where(sq.Eq{"wtv.is_latest": true})
+	} else {
+		query = query.Where(sq.Eq{"wtv.version": version})
+	}
+
+	result := &templateManifestVersion{}
+	if err := c.DB.Getx(result, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, notFound)
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// diffTemplateVersions loads two template versions with the query and compares them
+func (c *Client) diffTemplateVersions(namespace, uid string, query sq.SelectBuilder, fromVersion, toVersion int64, notFound string) (*TemplateVersionDiff, error) {
+	from, err := c.getTemplateManifestVersion(query, fromVersion, notFound)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := c.getTemplateManifestVersion(query, toVersion, notFound)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := diffTemplateManifests(from, to)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":   namespace,
+			"UID":         uid,
+			"FromVersion": fromVersion,
+			"ToVersion":   toVersion,
+			"Error":       err.Error(),
+		}).Error("Unable to compare template versions.")
+		return nil, util.NewUserError(codes.InvalidArgument, "Unable to compare template versions: "+err.Error())
+	}
+
+	return diff, nil
+}
+
+// DiffWorkflowTemplateVersions returns the changes to the manifest and parameters of a workflow template between two versions.
+// A version of 0 is the latest version.
+func (c *Client) DiffWorkflowTemplateVersions(namespace, uid string, fromVersion, toVersion int64) (*TemplateVersionDiff, error) {
+	query := sb.Select("wtv.version", "wtv.manifest").
+		From("workflow_template_versions wtv").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"wt.namespace":   namespace,
+			"wt.uid":         uid,
+			"wt.is_archived": false,
+		})
+
+	return c.diffTemplateVersions(namespace, uid, query, fromVersion, toVersion, "Workflow template version not found.")
+}
+
+// DiffWorkspaceTemplateVersions returns the changes to the manifest and parameters of a workspace template between two versions.
+// A version of 0 is the latest version.
+func (c *Client) DiffWorkspaceTemplateVersions(namespace, uid string, fromVersion, toVersion int64) (*TemplateVersionDiff, error) {
+	query := sb.Select("wtv.version", "wtv.manifest").
+		From("workspace_template_versions wtv").
+		Join("workspace_templates wt ON wt.id = wtv.workspace_template_id").
+		Where(sq.Eq{
+			"wt.namespace":   namespace,
+			"wt.uid":         uid,
+			"wt.is_archived": false,
+		})
+
+	return c.diffTemplateVersions(namespace, uid, query, fromVersion, toVersion, "Workspace template version not found.")
+}
@@ -0,0 +1,78 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/onepanelio/core/pkg/util/extensions"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_diffTemplateManifests makes sure the changes between two versions are listed by path, with the changed parameters
+func Test_diffTemplateManifests(t *testing.T) {
+	from := &templateManifestVersion{
+		Version: 1,
+		Manifest: `arguments:
+  parameters:
+  - name: epochs
+    value: "10"
+  - name: model
+    value: resnet
+entrypoint: main
+templates:
+- name: main
+  container:
+    image: python:3.8
+`,
+	}
+	to := &templateManifestVersion{
+		Version: 2,
+		Manifest: `arguments:
+  parameters:
+  - name: epochs
+    value: "20"
+  - name: batch-size
+    value: "32"
+entrypoint: main
+templates:
+- name: main
+  container:
+    image: python:3.9
+    command: [python]
+`,
+	}
+
+	diff, err := diffTemplateManifests(from, to)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), diff.FromVersion)
+	assert.Equal(t, int64(2), diff.ToVersion)
+
+	assert.Equal(t, []*extensions.NodeChange{
+		{Path: "arguments.parameters[name=epochs].value", Type: extensions.NodeChangeChanged, OldValue: `"10"`, NewValue: `"20"`},
+		{Path: "arguments.parameters[name=model]", Type: extensions.NodeChangeRemoved, OldValue: "name: model\nvalue: resnet"},
+		{Path: "arguments.parameters[name=batch-size]", Type: extensions.NodeChangeAdded, NewValue: "name: batch-size\nvalue: \"32\""},
+		{Path: "templates[name=main].container.image", Type: extensions.NodeChangeChanged, OldValue: "python:3.8", NewValue: "python:3.9"},
+		{Path: "templates[name=main].container.command", Type: extensions.NodeChangeAdded, NewValue: "[python]"},
+	}, diff.Changes)
+
+	assert.Len(t, diff.ParameterChanges, 3)
+	assert.Equal(t, "epochs", diff.ParameterChanges[0].Name)
+	assert.Equal(t, extensions.NodeChangeChanged, diff.ParameterChanges[0].Type)
+	assert.Equal(t, "10", *diff.ParameterChanges[0].OldValue)
+	assert.Equal(t, "20", *diff.ParameterChanges[0].NewValue)
+	assert.Equal(t, extensions.NodeChangeRemoved, diff.ParameterChanges[1].Type)
+	assert.Nil(t, diff.ParameterChanges[1].NewValue)
+	assert.Equal(t, "batch-size", diff.ParameterChanges[2].Name)
+	assert.Equal(t, extensions.NodeChangeAdded, diff.ParameterChanges[2].Type)
+}
+
+// Test_diffTemplateManifests_Same makes sure there are no changes between a version and itself
+func Test_diffTemplateManifests_Same(t *testing.T) {
+	version := &templateManifestVersion{
+		Manifest: "entrypoint: main\n",
+	}
+
+	diff, err := diffTemplateManifests(version, version)
+	assert.Nil(t, err)
+	assert.Empty(t, diff.Changes)
+	assert.Empty(t, diff.ParameterChanges)
+}
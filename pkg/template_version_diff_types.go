@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"reflect"
+
+	"github.com/onepanelio/core/pkg/util/extensions"
+)
+
+// ParameterChange is a difference between the parameters of two template versions.
+// Type is one of the extensions.NodeChange types.
+type ParameterChange struct {
+	Name     string  `json:"name"`
+	Type     string  `json:"type"`
+	OldValue *string `json:"oldValue,omitempty"`
+	NewValue *string `json:"newValue,omitempty"`
+	OldType  string  `json:"oldType,omitempty"`
+	NewType  string  `json:"newType,omitempty"`
+}
+
+// TemplateVersionDiff is the difference between two versions of a workflow or workspace template
+type TemplateVersionDiff struct {
+	FromVersion      int64
+	ToVersion        int64
+	Changes          []*extensions.NodeChange
+	ParameterChanges []*ParameterChange
+}
+
+// templateManifestVersion is the manifest of a template version
+type templateManifestVersion struct {
+	Version  int64
+	Manifest string
+}
+
+// diffParameters returns the parameters that were added, removed or changed, in the order they appear
+func diffParameters(from, to []Parameter) []*ParameterChange {
+	changes := make([]*ParameterChange, 0)
+
+	toParameters := make(map[string]*Parameter)
+	for i := range to {
+		toParameters[to[i].Name] = &to[i]
+	}
+
+	fromParameters := make(map[string]bool)
+	for i := range from {
+		fromParameter := &from[i]
+		fromParameters[fromParameter.Name] = true
+
+		toParameter, ok := toParameters[fromParameter.Name]
+		if !ok {
+			changes = append(changes, &ParameterChange{
+				Name:     fromParameter.Name,
+				Type:     extensions.NodeChangeRemoved,
+				OldValue: fromParameter.Value,
+				OldType:  fromParameter.Type,
+			})
+			continue
+		}
+
+		if !reflect.DeepEqual(fromParameter, toParameter) {
+			changes = append(changes, &ParameterChange{
+				Name:     fromParameter.Name,
+				Type:     extensions.NodeChangeChanged,
+				OldValue: fromParameter.Value,
+				NewValue: toParameter.Value,
+				OldType:  fromParameter.Type,
+				NewType:  toParameter.Type,
+			})
+		}
+	}
+
+	for i := range to {
+		toParameter := &to[i]
+		if fromParameters[toParameter.Name] {
+			continue
+		}
+
+		changes = append(changes, &ParameterChange{
+			Name:     toParameter.Name,
+			Type:     extensions.NodeChangeAdded,
+			NewValue: toParameter.Value,
+			NewType:  toParameter.Type,
+		})
+	}
+
+	return changes
+}
@@ -108,6 +108,7 @@ type WorkflowExecutionStatisticReport struct {
 	Completed          int32
 	Failed             int32
 	Terminated         int32
+	Suspended          int32
 }
 
 // WorkspaceStatisticReport contains stats on the phases the workspaces in the system are in
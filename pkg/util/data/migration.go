@@ -1,7 +1,9 @@
 package data
 
 import (
+	"bytes"
 	"gopkg.in/yaml.v3"
+	"io"
 	"io/ioutil"
 )
 
@@ -21,6 +23,7 @@ type ManifestFileMetadata struct {
 	Labels      map[string]string
 	Deprecated  *bool
 	Source      *string
+	Template    *string // name of the workflow template a CronWorkflow runs
 }
 
 // SpecString returns the spec of a manifest file as a string
@@ -47,3 +50,42 @@ func ManifestFileFromFile(path string) (*ManifestFile, error) {
 
 	return manifest, nil
 }
+
+// ManifestFilesFromBytes loads the manifests of a multi-document yaml file, e.g. one created by ManifestFilesToBytes.
+func ManifestFilesFromBytes(data []byte) ([]*ManifestFile, error) {
+	manifests := make([]*ManifestFile, 0)
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		manifest := &ManifestFile{}
+		if err := decoder.Decode(manifest); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		manifests = append(manifests, manifest)
+	}
+
+	return manifests, nil
+}
+
+// ManifestFilesToBytes writes the manifests as a multi-document yaml file.
+func ManifestFilesToBytes(manifests []*ManifestFile) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+
+	encoder := yaml.NewEncoder(buffer)
+	encoder.SetIndent(2)
+	for _, manifest := range manifests {
+		if err := encoder.Encode(manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
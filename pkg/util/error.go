@@ -6,13 +6,21 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 )
 
 // UserError implements a new error type for user facing errors
 type UserError struct {
-	Code    codes.Code
-	Message string
+	Code       codes.Code
+	Message    string
+	Violations []*FieldViolation
+}
+
+// FieldViolation describes why a field of a request is invalid
+type FieldViolation struct {
+	Field       string
+	Description string
 }
 
 // Error returns error messages
@@ -21,8 +29,27 @@ func (e *UserError) Error() string {
 }
 
 // GRPCStatus is used by gRPC to return the correct gRPC status codes
+// Field violations, if any, are added to the status as a BadRequest detail.
 func (e *UserError) GRPCStatus() *status.Status {
-	return status.New(e.Code, e.Message)
+	st := status.New(e.Code, e.Message)
+	if len(e.Violations) == 0 {
+		return st
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range e.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+
+	detailed, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st
+	}
+
+	return detailed
 }
 
 // NewUserError returns an instance of UserError with the appropriate code and message
@@ -30,6 +57,11 @@ func NewUserError(code codes.Code, message string) error {
 	return &UserError{Code: code, Message: message}
 }
 
+// NewFieldViolationsError returns an InvalidArgument UserError listing the invalid fields of a request
+func NewFieldViolationsError(message string, violations []*FieldViolation) error {
+	return &UserError{Code: codes.InvalidArgument, Message: message, Violations: violations}
+}
+
 func pqError(err *pq.Error) (code codes.Code) {
 	switch err.Code {
 	case "23505":
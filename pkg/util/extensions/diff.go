@@ -0,0 +1,245 @@
+package extensions
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Types of NodeChange
+const (
+	NodeChangeAdded   = "added"
+	NodeChangeRemoved = "removed"
+	NodeChangeChanged = "changed"
+)
+
+// NodeChange is a difference between two yaml trees at a path.
+// Paths separate mapping keys with "." and index sequences with [i],
+// or with [name=value] when every item of the sequence is a mapping with a unique name.
+// Values are rendered as yaml and are empty when the node does not exist on that side.
+type NodeChange struct {
+	Path     string
+	Type     string
+	OldValue string
+	NewValue string
+}
+
+// unwrapNode returns the content of a document node and resolves aliases. Empty nodes are returned as nil.
+func unwrapNode(node *yaml.Node) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+
+	if node.Kind == yaml.DocumentNode {
+		if len(node.Content) == 0 {
+			return nil
+		}
+		node = node.Content[0]
+	}
+
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+
+	if node == nil || node.Kind == 0 {
+		return nil
+	}
+
+	return node
+}
+
+// nodeToString renders the node as yaml, without the trailing new line
+func nodeToString(node *yaml.Node) (string, error) {
+	if node == nil {
+		return "", nil
+	}
+
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(data), "\n"), nil
+}
+
+// joinPath adds a mapping key to the path
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// sequenceNames returns the name of every item of the sequence, if they are all mappings with a unique scalar name
+func sequenceNames(node *yaml.Node) ([]string, bool) {
+	names := make([]string, len(node.Content))
+	seen := make(map[string]bool)
+	for i, item := range node.Content {
+		item = unwrapNode(item)
+		if item == nil || item.Kind != yaml.MappingNode {
+			return nil, false
+		}
+
+		nameNode, err := GetKeyValue(item, "name")
+		if err != nil || nameNode.Kind != yaml.ScalarNode || seen[nameNode.Value] {
+			return nil, false
+		}
+
+		seen[nameNode.Value] = true
+		names[i] = nameNode.Value
+	}
+
+	return names, true
+}
+
+// differ accumulates the changes between two yaml trees
+type differ struct {
+	changes []*NodeChange
+}
+
+// add records a change between the nodes at path
+func (d *differ) add(path, changeType string, from, to *yaml.Node) error {
+	oldValue, err := nodeToString(from)
+	if err != nil {
+		return err
+	}
+
+	newValue, err := nodeToString(to)
+	if err != nil {
+		return err
+	}
+
+	d.changes = append(d.changes, &NodeChange{
+		Path:     path,
+		Type:     changeType,
+		OldValue: oldValue,
+		NewValue: newValue,
+	})
+
+	return nil
+}
+
+// diff compares the nodes at path
+func (d *differ) diff(path string, from, to *yaml.Node) error {
+	from = unwrapNode(from)
+	to = unwrapNode(to)
+
+	if from == nil && to == nil {
+		return nil
+	}
+	if from == nil {
+		return d.add(path, NodeChangeAdded, nil, to)
+	}
+	if to == nil {
+		return d.add(path, NodeChangeRemoved, from, nil)
+	}
+	if from.Kind != to.Kind {
+		return d.add(path, NodeChangeChanged, from, to)
+	}
+
+	switch from.Kind {
+	case yaml.MappingNode:
+		return d.diffMappings(path, from, to)
+	case yaml.SequenceNode:
+		return d.diffSequences(path, from, to)
+	}
+
+	if from.Value != to.Value || from.ShortTag() != to.ShortTag() {
+		return d.add(path, NodeChangeChanged, from, to)
+	}
+
+	return nil
+}
+
+// diffMappings compares two mapping nodes, keys are reported in the order they appear
+func (d *differ) diffMappings(path string, from, to *yaml.Node) error {
+	for i := 0; i < len(from.Content)-1; i += 2 {
+		key := from.Content[i].Value
+		toValue, err := GetKeyValue(to, key)
+		if err != nil {
+			toValue = nil
+		}
+
+		if err := d.diff(joinPath(path, key), from.Content[i+1], toValue); err != nil {
+			return err
+		}
+	}
+
+	for i := 0; i < len(to.Content)-1; i += 2 {
+		key := to.Content[i].Value
+		if _, err := GetKeyValue(from, key); err == nil {
+			continue
+		}
+
+		if err := d.diff(joinPath(path, key), nil, to.Content[i+1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffSequences compares two sequence nodes, matching items by name when possible and by index otherwise
+func (d *differ) diffSequences(path string, from, to *yaml.Node) error {
+	fromNames, fromNamed := sequenceNames(from)
+	toNames, toNamed := sequenceNames(to)
+
+	if !fromNamed || !toNamed || len(from.Content) == 0 || len(to.Content) == 0 {
+		for i := 0; i < len(from.Content) || i < len(to.Content); i++ {
+			var fromItem, toItem *yaml.Node
+			if i < len(from.Content) {
+				fromItem = from.Content[i]
+			}
+			if i < len(to.Content) {
+				toItem = to.Content[i]
+			}
+
+			if err := d.diff(fmt.Sprintf("%v[%v]", path, i), fromItem, toItem); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	toItems := make(map[string]*yaml.Node)
+	for i, name := range toNames {
+		toItems[name] = to.Content[i]
+	}
+
+	fromItems := make(map[string]bool)
+	for i, name := range fromNames {
+		fromItems[name] = true
+		if err := d.diff(fmt.Sprintf("%v[name=%v]", path, name), from.Content[i], toItems[name]); err != nil {
+			return err
+		}
+	}
+
+	for i, name := range toNames {
+		if fromItems[name] {
+			continue
+		}
+
+		if err := d.diff(fmt.Sprintf("%v[name=%v]", path, name), nil, to.Content[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Diff returns the changes needed to go from the from yaml tree to the to yaml tree.
+// Changes are reported for the deepest path that differs, e.g. a changed scalar, an added key or a removed item.
+func Diff(from, to *yaml.Node) ([]*NodeChange, error) {
+	d := &differ{
+		changes: make([]*NodeChange, 0),
+	}
+
+	if err := d.diff("", from, to); err != nil {
+		return nil, err
+	}
+
+	return d.changes, nil
+}
@@ -13,6 +13,8 @@ const (
 	WorkspaceTemplateVersionUid = OnepanelPrefix + "workspace-template-version-uid"
 	WorkflowUid                 = OnepanelPrefix + "workflow-uid"
 	CronWorkflowUid             = OnepanelPrefix + "cron-workflow-uid"
+	WorkspaceUid                = OnepanelPrefix + "workspace-uid"
+	WorkspaceSnapshotUid        = OnepanelPrefix + "workspace-snapshot-uid"
 	Version                     = OnepanelPrefix + "version"
 	VersionLatest               = OnepanelPrefix + "version-latest"
 )
@@ -0,0 +1,212 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx/reflectx"
+)
+
+// cursorTimeLayout is the layout used to store timestamps in a cursor. Postgres parses it for timestamp columns.
+const cursorTimeLayout = "2006-01-02 15:04:05.999999"
+
+// mapper finds struct fields the same way sqlx does when scanning results
+var mapper = reflectx.NewMapperFunc("db", strings.ToLower)
+
+// KeysetColumn is a column used to order results when using keyset pagination.
+type KeysetColumn struct {
+	// Column is the column as it appears in the query, e.g. we.created_at
+	Column string
+	// Field is where the column is loaded in the result struct, e.g. created_at or status.phase
+	Field string
+	// Desc is true if the results are in descending order for this column
+	Desc bool
+}
+
+// OrderBy returns the ORDER BY expression for the column.
+// NULL values are always treated as the smallest values.
+func (k KeysetColumn) OrderBy() string {
+	if k.Desc {
+		return fmt.Sprintf("%v desc NULLS LAST", k.Column)
+	}
+
+	return fmt.Sprintf("%v asc NULLS FIRST", k.Column)
+}
+
+// after returns a condition for rows that come after value in the ordering of the column
+func (k KeysetColumn) after(value *string) squirrel.Sqlizer {
+	if value == nil {
+		if k.Desc {
+			return squirrel.Expr("FALSE")
+		}
+
+		return squirrel.NotEq{k.Column: nil}
+	}
+
+	if k.Desc {
+		return squirrel.Or{
+			squirrel.Lt{k.Column: *value},
+			squirrel.Eq{k.Column: nil},
+		}
+	}
+
+	return squirrel.Gt{k.Column: *value}
+}
+
+// equal returns a condition for rows that have the same value for the column
+func (k KeysetColumn) equal(value *string) squirrel.Sqlizer {
+	if value == nil {
+		return squirrel.Eq{k.Column: nil}
+	}
+
+	return squirrel.Eq{k.Column: *value}
+}
+
+// signature identifies the ordering of columns so a cursor is not used with a different one
+func signature(columns []KeysetColumn) string {
+	parts := make([]string, len(columns))
+	for i, column := range columns {
+		parts[i] = column.OrderBy()
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// Cursor represents the position of the last item of a page when using keyset pagination.
+type Cursor struct {
+	// Order is the signature of the columns the results were ordered by
+	Order string `json:"o"`
+	// Values are the values of the ordering columns for the last item, nil for NULL
+	Values []*string `json:"v"`
+}
+
+// Encode returns the cursor as an opaque token
+func (c *Cursor) Encode() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor parses a token created by Cursor.Encode
+func DecodeCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid continue token")
+	}
+
+	cursor := &Cursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("invalid continue token")
+	}
+
+	return cursor, nil
+}
+
+// NewCursor creates a cursor pointing at item, which is a struct (or pointer to one) loaded from the database.
+// An error is returned if a column value can not be represented in a cursor, e.g. JSON columns.
+func NewCursor(columns []KeysetColumn, item interface{}) (*Cursor, error) {
+	v := reflect.Indirect(reflect.ValueOf(item))
+
+	cursor := &Cursor{
+		Order:  signature(columns),
+		Values: make([]*string, len(columns)),
+	}
+
+	typeMap := mapper.TypeMap(v.Type())
+	for i, column := range columns {
+		fieldInfo, ok := typeMap.Names[column.Field]
+		if !ok {
+			return nil, fmt.Errorf("field '%v' not found", column.Field)
+		}
+
+		value, err := formatCursorValue(fieldByIndex(v, fieldInfo.Index))
+		if err != nil {
+			return nil, err
+		}
+		cursor.Values[i] = value
+	}
+
+	return cursor, nil
+}
+
+// fieldByIndex returns the nested field of v. Unlike reflectx, nil pointers along the way are not allocated,
+// an invalid value is returned instead.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+
+	return v
+}
+
+// formatCursorValue formats a scalar value so it can be stored in a cursor and compared against in SQL
+func formatCursorValue(v reflect.Value) (*string, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	result := ""
+	if t, ok := v.Interface().(time.Time); ok {
+		result = t.UTC().Format(cursorTimeLayout)
+		return &result, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		result = v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		result = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		result = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Bool:
+		result = strconv.FormatBool(v.Bool())
+	default:
+		return nil, fmt.Errorf("unsupported cursor value of type %v", v.Type())
+	}
+
+	return &result, nil
+}
+
+// Where returns a condition that selects the rows after the cursor for the columns.
+//
+// For columns (a, b) and values (x, y) this is (a after x) OR (a = x AND b after y)
+func (c *Cursor) Where(columns []KeysetColumn) (squirrel.Sqlizer, error) {
+	if c.Order != signature(columns) || len(c.Values) != len(columns) {
+		return nil, fmt.Errorf("continue token does not match the requested order")
+	}
+
+	result := squirrel.Or{}
+	for i := range columns {
+		condition := squirrel.And{}
+		for j := 0; j < i; j++ {
+			condition = append(condition, columns[j].equal(c.Values[j]))
+		}
+		condition = append(condition, columns[i].after(c.Values[i]))
+
+		result = append(result, condition)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,160 @@
+package pagination
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+)
+
+type cursorTestStatus struct {
+	Phase string `db:"phase"`
+}
+
+type cursorTestItem struct {
+	ID         uint64
+	CreatedAt  time.Time        `db:"created_at"`
+	FinishedAt *time.Time       `db:"finished_at"`
+	Status     cursorTestStatus `db:"status"`
+	Labels     map[string]string
+}
+
+var cursorTestColumns = []KeysetColumn{
+	{Column: "t.created_at", Field: "created_at", Desc: true},
+	{Column: "t.id", Field: "id", Desc: true},
+}
+
+func Test_Cursor_EncodeDecode(t *testing.T) {
+	value := "2020-01-02 03:04:05.123456"
+	cursor := &Cursor{
+		Order:  signature(cursorTestColumns),
+		Values: []*string{&value, nil},
+	}
+
+	token, err := cursor.Encode()
+	assert.Nil(t, err)
+
+	decoded, err := DecodeCursor(token)
+	assert.Nil(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func Test_DecodeCursor_Invalid(t *testing.T) {
+	_, err := DecodeCursor("not a token!")
+	assert.NotNil(t, err)
+}
+
+func Test_NewCursor(t *testing.T) {
+	item := &cursorTestItem{
+		ID:        12,
+		CreatedAt: time.Date(2020, 1, 2, 3, 4, 5, 123456000, time.UTC),
+		Status:    cursorTestStatus{Phase: "Running"},
+	}
+
+	columns := []KeysetColumn{
+		{Column: "t.created_at", Field: "created_at"},
+		{Column: "t.finished_at", Field: "finished_at"},
+		{Column: "t.phase", Field: "status.phase"},
+		{Column: "t.id", Field: "id"},
+	}
+
+	cursor, err := NewCursor(columns, item)
+	assert.Nil(t, err)
+	assert.Equal(t, "2020-01-02 03:04:05.123456", *cursor.Values[0])
+	assert.Nil(t, cursor.Values[1])
+	assert.Equal(t, "Running", *cursor.Values[2])
+	assert.Equal(t, "12", *cursor.Values[3])
+}
+
+func Test_NewCursor_Unsupported(t *testing.T) {
+	columns := []KeysetColumn{
+		{Column: "t.labels", Field: "labels"},
+	}
+
+	_, err := NewCursor(columns, &cursorTestItem{Labels: map[string]string{"a": "b"}})
+	assert.NotNil(t, err)
+}
+
+func Test_Cursor_Where(t *testing.T) {
+	value := "2020-01-02 03:04:05"
+	id := "12"
+	cursor := &Cursor{
+		Order:  signature(cursorTestColumns),
+		Values: []*string{&value, &id},
+	}
+
+	where, err := cursor.Where(cursorTestColumns)
+	assert.Nil(t, err)
+
+	sql, args, err := where.ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "(((t.created_at < ? OR t.created_at IS NULL)) OR (t.created_at = ? AND (t.id < ? OR t.id IS NULL)))", sql)
+	assert.Equal(t, []interface{}{value, value, id}, args)
+}
+
+func Test_Cursor_Where_OrderMismatch(t *testing.T) {
+	cursor := &Cursor{
+		Order:  "t.name asc NULLS FIRST",
+		Values: []*string{nil},
+	}
+
+	_, err := cursor.Where(cursorTestColumns)
+	assert.NotNil(t, err)
+}
+
+func Test_PaginationRequest_ApplyKeysetToSelect(t *testing.T) {
+	sb := squirrel.Select("*").From("test t")
+
+	pr := New(2, 10)
+	result, err := pr.ApplyKeysetToSelect(&sb, cursorTestColumns)
+	assert.Nil(t, err)
+
+	sql, _, err := result.ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT * FROM test t ORDER BY t.created_at desc NULLS LAST, t.id desc NULLS LAST LIMIT 10 OFFSET 10", sql)
+}
+
+func Test_PaginationRequest_SetNextContinue(t *testing.T) {
+	items := []*cursorTestItem{
+		{ID: 2, CreatedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{ID: 1, CreatedAt: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	pr := New(1, 3)
+	assert.Nil(t, pr.SetNextContinue(cursorTestColumns, items))
+	assert.Equal(t, "", pr.NextContinue)
+
+	pr = New(1, 2)
+	assert.Nil(t, pr.SetNextContinue(cursorTestColumns, items))
+	assert.NotEqual(t, "", pr.NextContinue)
+
+	next := NewWithContinue(0, 2, pr.NextContinue)
+	sb := squirrel.Select("*").From("test t")
+	result, err := next.ApplyKeysetToSelect(&sb, cursorTestColumns)
+	assert.Nil(t, err)
+
+	sql, args, err := result.ToSql()
+	assert.Nil(t, err)
+	assert.Contains(t, sql, "WHERE")
+	assert.NotContains(t, sql, "OFFSET")
+	assert.Equal(t, "1", args[len(args)-1])
+}
+
+func Test_PaginationRequest_SetNextContinue_Unsupported(t *testing.T) {
+	items := []*cursorTestItem{
+		{ID: 1, Labels: map[string]string{"a": "b"}},
+	}
+	columns := []KeysetColumn{
+		{Column: "t.labels", Field: "labels"},
+		{Column: "t.id", Field: "id"},
+	}
+
+	pr := New(1, 1)
+	assert.Nil(t, pr.SetNextContinue(columns, items))
+	assert.Equal(t, "", pr.NextContinue)
+
+	pr = NewWithContinue(0, 1, "token")
+	assert.NotNil(t, pr.SetNextContinue(columns, items))
+	assert.Equal(t, "", pr.NextContinue)
+}
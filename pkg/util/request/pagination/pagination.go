@@ -3,11 +3,18 @@ package pagination
 import (
 	"github.com/Masterminds/squirrel"
 	"math"
+	"reflect"
 )
 
 type PaginationRequest struct {
 	Page     uint64
 	PageSize uint64
+	// Continue is a token from a previous page. If set, results start after the last item of that page
+	// instead of at an offset, and Page is ignored.
+	Continue string
+	// NextContinue is set by SetNextContinue to a token for the page after the loaded one.
+	// It is empty if there are no more results.
+	NextContinue string
 }
 
 // New creates a new pagination request from the page and page size.
@@ -33,6 +40,14 @@ func NewRequest(page, pageSize int32) PaginationRequest {
 	}
 }
 
+// NewWithContinue creates a new pagination request from the page, page size and continue token.
+func NewWithContinue(page, pageSize int32, continueToken string) *PaginationRequest {
+	pr := New(page, pageSize)
+	pr.Continue = continueToken
+
+	return pr
+}
+
 // Start creates a new PaginationRequest that starts at the first page.
 // You can provide an optional pageSize argument. If none is provided, 15 is used.
 // All arguments apart from the first one are ignored.
@@ -67,6 +82,79 @@ func (pr *PaginationRequest) ApplyToSelect(sb *squirrel.SelectBuilder) *squirrel
 	return &result
 }
 
+// ApplyKeysetToSelect orders the selectBuilder by the columns and applies the pagination to it.
+// If there is a Continue token, only the rows after it are selected. Otherwise, an offset is used.
+func (pr *PaginationRequest) ApplyKeysetToSelect(sb *squirrel.SelectBuilder, columns []KeysetColumn) (*squirrel.SelectBuilder, error) {
+	result := *sb
+	for _, column := range columns {
+		result = result.OrderBy(column.OrderBy())
+	}
+
+	if pr == nil {
+		return &result, nil
+	}
+
+	if pr.Continue == "" {
+		return pr.ApplyToSelect(&result), nil
+	}
+
+	cursor, err := DecodeCursor(pr.Continue)
+	if err != nil {
+		return nil, err
+	}
+
+	where, err := cursor.Where(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	result = result.Where(where).
+		Limit(pr.PageSize)
+
+	return &result, nil
+}
+
+// SetNextContinue sets NextContinue to a token pointing at the last item of items, which is a slice of results
+// loaded with ApplyKeysetToSelect.
+// If the page is not full, there is no next token. If the token can not be created, e.g. when the results are sorted
+// by a column that can not be stored in a token, there is no next token either, and an error is returned only
+// if the page was loaded with a Continue token. Pages loaded with an offset keep paging by offset.
+func (pr *PaginationRequest) SetNextContinue(columns []KeysetColumn, items interface{}) error {
+	if pr == nil {
+		return nil
+	}
+
+	pr.NextContinue = ""
+
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice || v.Len() == 0 || uint64(v.Len()) < pr.PageSize {
+		return nil
+	}
+
+	token, err := newContinueToken(columns, v.Index(v.Len()-1).Interface())
+	if err != nil {
+		if pr.Continue == "" {
+			return nil
+		}
+
+		return err
+	}
+
+	pr.NextContinue = token
+
+	return nil
+}
+
+// newContinueToken returns a token pointing at the item, see NewCursor
+func newContinueToken(columns []KeysetColumn, item interface{}) (string, error) {
+	cursor, err := NewCursor(columns, item)
+	if err != nil {
+		return "", err
+	}
+
+	return cursor.Encode()
+}
+
 // Advance returns a new pagination request with the page incremented by 1
 func (pr *PaginationRequest) Advance() *PaginationRequest {
 	return &PaginationRequest{
@@ -34,3 +34,68 @@ func (r *Request) ApplyPaginationToSelect(sb *squirrel.SelectBuilder) *squirrel.
 
 	return r.Pagination.ApplyToSelect(sb)
 }
+
+// ApplyKeysetPaginationToSelect orders the selectBuilder by the columns and applies the pagination to it.
+// See PaginationRequest.ApplyKeysetToSelect
+func (r *Request) ApplyKeysetPaginationToSelect(sb *squirrel.SelectBuilder, columns []pagination.KeysetColumn) (*squirrel.SelectBuilder, error) {
+	if r == nil {
+		return (*pagination.PaginationRequest)(nil).ApplyKeysetToSelect(sb, columns)
+	}
+
+	return r.Pagination.ApplyKeysetToSelect(sb, columns)
+}
+
+// SetNextContinue sets the next continue token of the pagination, if there is a pagination.
+// See PaginationRequest.SetNextContinue
+func (r *Request) SetNextContinue(columns []pagination.KeysetColumn, items interface{}) error {
+	if r == nil {
+		return nil
+	}
+
+	return r.Pagination.SetNextContinue(columns, items)
+}
+
+// KeysetColumns returns the columns to order results by for keyset pagination.
+//
+// properties maps sort properties to columns, and fields maps columns to where they are loaded in the result struct.
+// If a column is not in fields, it is loaded in a field with the same name.
+// Columns are prefixed with alias. If there is no sorting, results are ordered by most recently created first.
+// The id column is always added last so the order is unique.
+func (r *Request) KeysetColumns(alias string, properties map[string]string, fields map[string]string) []pagination.KeysetColumn {
+	columns := make([]pagination.KeysetColumn, 0)
+	newColumn := func(name string, desc bool) pagination.KeysetColumn {
+		field, ok := fields[name]
+		if !ok {
+			field = name
+		}
+
+		return pagination.KeysetColumn{
+			Column: alias + "." + name,
+			Field:  field,
+			Desc:   desc,
+		}
+	}
+
+	if r.HasSorting() {
+		for _, order := range r.Sort.Properties {
+			columnName, ok := properties[order.Property]
+			if !ok {
+				continue
+			}
+
+			column := newColumn(columnName, order.Direction == "desc")
+			columns = append(columns, column)
+
+			// id is unique, so there is nothing left to order by
+			if columnName == "id" {
+				return columns
+			}
+		}
+	}
+
+	if len(columns) == 0 {
+		columns = append(columns, newColumn("created_at", true))
+	}
+
+	return append(columns, newColumn("id", columns[0].Desc))
+}
@@ -9,7 +9,9 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -162,6 +164,10 @@ func applyWorkflowExecutionFilter(sb sq.SelectBuilder, request *request.Request)
 		sb = sb.Where(sq.Eq{
 			"we.phase": "Terminated",
 		})
+	case "suspended":
+		sb = sb.Where(sq.Eq{
+			"we.phase": WorkflowExecutionSuspended,
+		})
 	default:
 		return sb, fmt.Errorf("unknown workflow execution phase filter '%v'", filter.Phase)
 	}
@@ -1159,31 +1165,25 @@ func (c *Client) GetWorkflowExecution(namespace, uid string) (workflow *Workflow
 func (c *Client) ListWorkflowExecutions(namespace, workflowTemplateUID, workflowTemplateVersion string, includeSystem bool, request *request.Request) (workflows []*WorkflowExecution, err error) {
 	sb := workflowExecutionsSelectBuilder(namespace, workflowTemplateUID, workflowTemplateVersion, includeSystem)
 
-	if request.HasSorting() {
-		properties := getWorkflowExecutionColumnsMap(true)
-		for _, order := range request.Sort.Properties {
-			if columnName, ok := properties[order.Property]; ok {
-				nullSort := "NULLS FIRST"
-				if order.Direction == "desc" {
-					nullSort = "NULLS LAST" // default in postgres, but let's be explicit
-				}
-				sb = sb.OrderBy(fmt.Sprintf("we.%v %v %v", columnName, order.Direction, nullSort))
-			}
-		}
-	} else {
-		sb = sb.OrderBy("we.created_at DESC")
-	}
-
 	sb, err = applyWorkflowExecutionFilter(sb, request)
 	if err != nil {
 		return nil, err
 	}
 
-	sb = *request.ApplyPaginationToSelect(&sb)
-	if err := c.DB.Selectx(&workflows, sb); err != nil {
+	keysetColumns := request.KeysetColumns("we", getWorkflowExecutionColumnsMap(true), nil)
+	paginatedSb, err := request.ApplyKeysetPaginationToSelect(&sb, keysetColumns)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	if err := c.DB.Selectx(&workflows, *paginatedSb); err != nil {
 		return nil, err
 	}
 
+	if err := request.SetNextContinue(keysetColumns, workflows); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
 	return
 }
 
@@ -1333,28 +1333,19 @@ func (c *Client) WatchWorkflowExecution(namespace, uid string) (<-chan *Workflow
 	return workflowWatcher, nil
 }
 
-func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName string) (<-chan []*LogEntry, error) {
-	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
-	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace":     namespace,
-			"UID":           uid,
-			"PodName":       podName,
-			"ContainerName": containerName,
-			"Error":         err.Error(),
-		}).Error("Workflow not found.")
-		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
-	}
-
+// openWorkflowExecutionLog opens the log of a container of a workflow execution pod.
+// Logs of completed pods are read from the artifact repository, other logs are read from kubernetes.
+// If tail is true, only the last ARTIFACT_RERPOSITORY_OBJECT_RANGE bytes of archived S3 logs are read and live logs are followed.
+// Archived GCS logs are always read in full.
+func (c *Client) openWorkflowExecutionLog(namespace, uid, podName, containerName string, completed, tail bool) (stream io.ReadCloser, err error) {
 	var (
-		stream    io.ReadCloser
 		s3Client  *s3.Client
 		gcsClient *gcs.Client
 		config    *NamespaceConfig
 		endOffset int
 	)
 
-	if wf.Status.Nodes[podName].Completed() {
+	if completed {
 		config, err = c.GetNamespaceConfig(namespace)
 		if err != nil {
 			log.WithFields(log.Fields{
@@ -1383,20 +1374,26 @@ func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName
 				}
 
 				opts := s3.GetObjectOptions{}
-				endOffset, err = strconv.Atoi(readEndOffset)
-				if err != nil {
-					return nil, util.NewUserError(codes.InvalidArgument, "Invalid range.")
-				}
-				err = opts.SetRange(0, int64(endOffset))
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't set range.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
+				if tail {
+					endOffset, err = strconv.Atoi(readEndOffset)
+					if err != nil {
+						return nil, util.NewUserError(codes.InvalidArgument, "Invalid range.")
+					}
+					// A negative end is a suffix range, so only the last bytes of the object are downloaded
+					if endOffset > 0 {
+						endOffset = -endOffset
+					}
+					err = opts.SetRange(0, int64(endOffset))
+					if err != nil {
+						log.WithFields(log.Fields{
+							"Namespace":     namespace,
+							"UID":           uid,
+							"PodName":       podName,
+							"ContainerName": containerName,
+							"Error":         err.Error(),
+						}).Error("Can't set range.")
+						return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
+					}
 				}
 
 				key := config.ArtifactRepository.S3.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
@@ -1418,11 +1415,13 @@ func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName
 				key := config.ArtifactRepository.GCS.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
 				stream, err = gcsClient.GetObject(config.ArtifactRepository.GCS.Bucket, key)
 			}
+		default:
+			return nil, util.NewUserError(codes.NotFound, "Artifact repository is not configured.")
 		}
 	} else {
 		stream, err = c.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
 			Container:  containerName,
-			Follow:     true,
+			Follow:     tail,
 			Timestamps: true,
 		}).Stream()
 	}
@@ -1439,6 +1438,27 @@ func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName
 		return nil, util.NewUserError(codes.NotFound, "Log not found.")
 	}
 
+	return
+}
+
+func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName string) (<-chan []*LogEntry, error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":     namespace,
+			"UID":           uid,
+			"PodName":       podName,
+			"ContainerName": containerName,
+			"Error":         err.Error(),
+		}).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	stream, err := c.openWorkflowExecutionLog(namespace, uid, podName, containerName, wf.Status.Nodes[podName].Completed(), true)
+	if err != nil {
+		return nil, err
+	}
+
 	logWatcher := make(chan []*LogEntry)
 	go func() {
 		buffer := make([]byte, 4096)
@@ -1604,17 +1624,396 @@ func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (me
 	return
 }
 
-func (c *Client) RetryWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, err error) {
+// metaTimeToTime returns a pointer to the time, or nil if it is not set
+func metaTimeToTime(t metav1.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	result := t.Time.UTC()
+	return &result
+}
+
+// nodeDuration returns the duration of the node. Nodes that have not finished yet report the time since they started.
+func nodeDuration(startedAt, finishedAt metav1.Time) time.Duration {
+	if startedAt.IsZero() {
+		return 0
+	}
+
+	if finishedAt.IsZero() {
+		return time.Since(startedAt.Time)
+	}
+
+	return finishedAt.Sub(startedAt.Time)
+}
+
+// argoParametersToParameters converts argo parameters to Parameters, keeping only their name and value
+func argoParametersToParameters(parameters []wfv1.Parameter) []Parameter {
+	result := make([]Parameter, 0, len(parameters))
+	for _, p := range parameters {
+		parameter := Parameter{
+			Name: p.Name,
+		}
+		if p.Value != nil {
+			parameter.Value = ptr.String(p.Value.String())
+		}
+
+		result = append(result, parameter)
+	}
+
+	return result
+}
+
+// resolveWorkflowExecutionArtifacts returns the bucket and key of each artifact.
+// Artifacts that have a location use it, otherwise the key is generated from the namespace artifact repository
+// the same way the argo executor does when saving artifacts.
+func resolveWorkflowExecutionArtifacts(artifacts wfv1.Artifacts, repository *ArtifactRepositoryProvider, namespace, workflowName, nodeID string) []WorkflowExecutionArtifact {
+	result := make([]WorkflowExecutionArtifact, 0, len(artifacts))
+	for _, art := range artifacts {
+		artifact := WorkflowExecutionArtifact{
+			Name: art.Name,
+			Path: art.Path,
+		}
+
+		switch {
+		case art.S3 != nil && art.S3.Key != "":
+			artifact.Bucket = art.S3.Bucket
+			artifact.Key = art.S3.Key
+		case art.GCS != nil && art.GCS.Key != "":
+			artifact.Bucket = art.GCS.Bucket
+			artifact.Key = art.GCS.Key
+		case repository != nil:
+			fileName := art.Name + ".tgz"
+			if art.Archive != nil && art.Archive.None != nil {
+				fileName = filepath.Base(art.Path)
+			}
+
+			if repository.S3 != nil {
+				artifact.Key = repository.S3.FormatKey(namespace, workflowName, nodeID) + "/" + fileName
+			} else if repository.GCS != nil {
+				artifact.Key = repository.GCS.FormatKey(namespace, workflowName, nodeID) + "/" + fileName
+			}
+		}
+
+		if artifact.Bucket == "" && repository != nil {
+			if repository.S3 != nil {
+				artifact.Bucket = repository.S3.Bucket
+			} else if repository.GCS != nil {
+				artifact.Bucket = repository.GCS.Bucket
+			}
+		}
+
+		result = append(result, artifact)
+	}
+
+	return result
+}
+
+// newWorkflowExecutionGraph creates a normalized graph from the status nodes of the argo workflow.
+// Nodes are sorted by the time they started, nodes that have not started yet are last.
+func newWorkflowExecutionGraph(wf *wfv1.Workflow, repository *ArtifactRepositoryProvider) *WorkflowExecutionGraph {
+	graph := &WorkflowExecutionGraph{
+		UID:        wf.Name,
+		Phase:      wf.Status.Phase,
+		StartedAt:  metaTimeToTime(wf.Status.StartedAt),
+		FinishedAt: metaTimeToTime(wf.Status.FinishedAt),
+		Duration:   nodeDuration(wf.Status.StartedAt, wf.Status.FinishedAt),
+		Nodes:      make([]*WorkflowExecutionNode, 0, len(wf.Status.Nodes)),
+	}
+
+	parents := make(map[string][]string)
+	for id, node := range wf.Status.Nodes {
+		for _, child := range node.Children {
+			parents[child] = append(parents[child], id)
+		}
+	}
+
+	for id, node := range wf.Status.Nodes {
+		graphNode := &WorkflowExecutionNode{
+			ID:           id,
+			Name:         node.Name,
+			DisplayName:  node.DisplayName,
+			Type:         node.Type,
+			TemplateName: node.TemplateName,
+			Phase:        node.Phase,
+			Message:      node.Message,
+			Parents:      parents[id],
+			Children:     node.Children,
+			StartedAt:    metaTimeToTime(node.StartedAt),
+			FinishedAt:   metaTimeToTime(node.FinishedAt),
+			Duration:     nodeDuration(node.StartedAt, node.FinishedAt),
+			Inputs:       make([]Parameter, 0),
+			Outputs:      make([]Parameter, 0),
+		}
+
+		if graphNode.Parents == nil {
+			graphNode.Parents = make([]string, 0)
+		}
+		sort.Strings(graphNode.Parents)
+		if graphNode.Children == nil {
+			graphNode.Children = make([]string, 0)
+		}
+
+		// Each attempt of a retry node is a child, the first one is not a retry
+		if node.Type == wfv1.NodeTypeRetry && len(node.Children) > 0 {
+			graphNode.Retries = len(node.Children) - 1
+		}
+
+		if node.Inputs != nil {
+			graphNode.Inputs = argoParametersToParameters(node.Inputs.Parameters)
+			graphNode.InputArtifacts = resolveWorkflowExecutionArtifacts(node.Inputs.Artifacts, repository, wf.Namespace, wf.Name, id)
+		}
+		if node.Outputs != nil {
+			graphNode.Outputs = argoParametersToParameters(node.Outputs.Parameters)
+			graphNode.OutputArtifacts = resolveWorkflowExecutionArtifacts(node.Outputs.Artifacts, repository, wf.Namespace, wf.Name, id)
+		}
+
+		graph.Nodes = append(graph.Nodes, graphNode)
+	}
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		a, b := graph.Nodes[i], graph.Nodes[j]
+		if a.StartedAt == nil || b.StartedAt == nil {
+			if a.StartedAt != b.StartedAt {
+				return b.StartedAt == nil
+			}
+			return a.ID < b.ID
+		}
+
+		if !a.StartedAt.Equal(*b.StartedAt) {
+			return a.StartedAt.Before(*b.StartedAt)
+		}
+
+		return a.ID < b.ID
+	})
+
+	return graph
+}
+
+// GetWorkflowExecutionGraph returns the graph of nodes of the workflow execution with their status, timings and artifacts
+func (c *Client) GetWorkflowExecutionGraph(namespace, uid string) (graph *WorkflowExecutionGraph, err error) {
 	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
 	if err != nil {
-		return
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	config, err := c.GetNamespaceConfig(namespace)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Can't get configuration.")
+		return nil, util.NewUserError(codes.NotFound, "Can't get configuration.")
+	}
+
+	return newWorkflowExecutionGraph(wf, &config.ArtifactRepository), nil
+}
+
+// stringPtrEqual returns true if both strings are nil or have the same value
+func stringPtrEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// compareWorkflowExecutions compares the parameters and metrics of the workflow executions.
+// For metrics, the highest value is the best unless the metric name is in minimize.
+func compareWorkflowExecutions(workflowExecutions []*WorkflowExecution, minimize map[string]bool) *WorkflowExecutionComparison {
+	comparison := &WorkflowExecutionComparison{
+		WorkflowExecutions: workflowExecutions,
+		Parameters:         make([]*WorkflowExecutionParameterComparison, 0),
+		Metrics:            make([]*WorkflowExecutionMetricComparison, 0),
+	}
+
+	parameters := make(map[string]*WorkflowExecutionParameterComparison)
+	metrics := make(map[string]*WorkflowExecutionMetricComparison)
+	for i, we := range workflowExecutions {
+		for _, p := range we.Parameters {
+			parameter, ok := parameters[p.Name]
+			if !ok {
+				parameter = &WorkflowExecutionParameterComparison{
+					Name:   p.Name,
+					Values: make([]*string, len(workflowExecutions)),
+				}
+				parameters[p.Name] = parameter
+				comparison.Parameters = append(comparison.Parameters, parameter)
+			}
+			parameter.Values[i] = p.Value
+		}
+
+		for _, m := range we.Metrics {
+			metric, ok := metrics[m.Name]
+			if !ok {
+				metric = &WorkflowExecutionMetricComparison{
+					Name:   m.Name,
+					Format: m.Format,
+					Values: make([]*float64, len(workflowExecutions)),
+				}
+				metrics[m.Name] = metric
+				comparison.Metrics = append(comparison.Metrics, metric)
+			}
+
+			// Only the first metric with a name is compared
+			if metric.Values[i] == nil {
+				value := m.Value
+				metric.Values[i] = &value
+			}
+		}
+	}
+
+	for _, parameter := range comparison.Parameters {
+		for _, value := range parameter.Values[1:] {
+			if !stringPtrEqual(value, parameter.Values[0]) {
+				parameter.Differs = true
+				break
+			}
+		}
+	}
+
+	for _, metric := range comparison.Metrics {
+		var best *float64
+		for i, value := range metric.Values {
+			if value == nil {
+				metric.Differs = true
+				continue
+			}
+
+			if best != nil && *value != *best {
+				metric.Differs = true
+			}
+
+			if best == nil || (minimize[metric.Name] && *value < *best) || (!minimize[metric.Name] && *value > *best) {
+				best = value
+				metric.BestUID = workflowExecutions[i].UID
+			}
+		}
+	}
+
+	return comparison
+}
+
+// CompareWorkflowExecutions returns a side by side comparison of the workflow executions with the given uids.
+// The workflow executions are returned in the same order as the uids.
+// For metrics, the highest value is the best unless the metric name is in minimizeMetrics.
+func (c *Client) CompareWorkflowExecutions(namespace string, uids []string, minimizeMetrics []string) (comparison *WorkflowExecutionComparison, err error) {
+	if len(uids) < 2 {
+		return nil, util.NewUserError(codes.InvalidArgument, "At least two workflow executions are required for a comparison.")
+	}
+
+	query := workflowExecutionsSelectBuilder(namespace, "", "", true).
+		Where(sq.Eq{"we.name": uids})
+
+	workflowExecutions := make([]*WorkflowExecution, 0)
+	if err := c.DB.Selectx(&workflowExecutions, query); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UIDs":      uids,
+			"Error":     err.Error(),
+		}).Error("Unable to get workflow executions.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to get workflow executions.")
+	}
+
+	workflowExecutionsByUID := make(map[string]*WorkflowExecution)
+	for _, we := range workflowExecutions {
+		if _, err := we.LoadParametersFromBytes(); err != nil {
+			return nil, err
+		}
+		workflowExecutionsByUID[we.Name] = we
+	}
+
+	ordered := make([]*WorkflowExecution, len(uids))
+	for i, uid := range uids {
+		we, ok := workflowExecutionsByUID[uid]
+		if !ok {
+			return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Workflow execution '%v' not found.", uid))
+		}
+		ordered[i] = we
+	}
+
+	minimize := make(map[string]bool)
+	for _, name := range minimizeMetrics {
+		minimize[name] = true
+	}
+
+	return compareWorkflowExecutions(ordered, minimize), nil
+}
+
+// setWorkflowExecutionPhase updates the phase of the workflow execution in the database.
+// Running workflow executions have not finished, so their finished_at is cleared.
+func (c *Client) setWorkflowExecutionPhase(namespace, uid string, phase wfv1.NodePhase) error {
+	fieldMap := sq.Eq{
+		"phase": phase,
+	}
+	if phase == wfv1.NodeRunning {
+		fieldMap["finished_at"] = nil
+	}
+
+	_, err := sb.Update("workflow_executions").
+		SetMap(fieldMap).
+		Where(sq.Eq{
+			"uid":       uid,
+			"namespace": namespace,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// RetryWorkflowExecution retries a failed workflow execution. Steps that succeeded are kept and failed steps are run again.
+// If nodeID is not empty, that node and all of its children are run again, even if they succeeded.
+func (c *Client) RetryWorkflowExecution(namespace, uid, nodeID string) (workflow *WorkflowExecution, err error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	if wf.Status.Phase != wfv1.NodeFailed && wf.Status.Phase != wfv1.NodeError {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Only failed workflow executions can be retried.")
+	}
+
+	nodeFieldSelector := ""
+	if nodeID != "" {
+		if _, ok := wf.Status.Nodes[nodeID]; !ok {
+			return nil, util.NewUserError(codes.NotFound, "Node not found.")
+		}
+		nodeFieldSelector = "id=" + nodeID
 	}
 
 	hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
 
-	wf, err = argoutil.RetryWorkflow(c, hy, c.ArgoprojV1alpha1().Workflows(namespace), uid, true, "")
+	_, err = argoutil.RetryWorkflow(c, hy, c.ArgoprojV1alpha1().Workflows(namespace), uid, nodeID != "", nodeFieldSelector)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"NodeID":    nodeID,
+			"Error":     err.Error(),
+		}).Error("Unable to retry workflow.")
+		return nil, util.NewUserError(codes.FailedPrecondition, "Unable to retry workflow.")
+	}
 
-	workflow = typeWorkflow(wf)
+	if err := c.setWorkflowExecutionPhase(namespace, uid, wfv1.NodeRunning); err != nil {
+		return nil, err
+	}
+
+	workflow, err = c.GetWorkflowExecution(namespace, uid)
+	if err == nil && workflow == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workflow execution not found")
+	}
 
 	return
 }
@@ -1640,24 +2039,58 @@ func (c *Client) ResubmitWorkflowExecution(namespace, uid string) (workflow *Wor
 	return
 }
 
+// ResumeWorkflowExecution resumes a suspended workflow execution and marks it as running in the database
 func (c *Client) ResumeWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, err error) {
 	hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
 	err = argoutil.ResumeWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), hy, uid, "")
 	if err != nil {
-		return
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to resume workflow.")
+		return nil, util.NewUserError(codes.FailedPrecondition, "Unable to resume workflow.")
 	}
 
-	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err := c.setWorkflowExecutionPhase(namespace, uid, wfv1.NodeRunning); err != nil {
+		return nil, err
+	}
 
-	workflow = typeWorkflow(wf)
+	workflow, err = c.GetWorkflowExecution(namespace, uid)
+	if err == nil && workflow == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workflow execution not found")
+	}
 
 	return
 }
 
+// SuspendWorkflowExecution suspends a running workflow execution and marks it as suspended in the database
 func (c *Client) SuspendWorkflowExecution(namespace, uid string) (err error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Workflow not found.")
+		return util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	if wf.Status.Phase != wfv1.NodeRunning && wf.Status.Phase != wfv1.NodePending {
+		return util.NewUserError(codes.FailedPrecondition, "Only running workflow executions can be suspended.")
+	}
+
 	err = argoutil.SuspendWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), uid)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to suspend workflow.")
+		return util.NewUserError(codes.FailedPrecondition, "Unable to suspend workflow.")
+	}
 
-	return
+	return c.setWorkflowExecutionPhase(namespace, uid, WorkflowExecutionSuspended)
 }
 
 // TerminateWorkflowExecution marks a workflows execution as terminated in DB and terminates the argo resource.
@@ -1876,6 +2309,7 @@ func (c *Client) GetWorkflowExecutionStatisticsForNamespace(namespace string) (r
 		COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND phase = 'Succeeded') completed,
 		COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND (phase = 'Failed' OR phase = 'Error')) failed,
 		COUNT(*) FILTER (WHERE phase = 'Terminated') terminated,
+		COUNT(*) FILTER (WHERE phase = 'Suspended') suspended,
 		COUNT(*) total`
 
 	query := sb.Select(statsSelect).
@@ -1919,6 +2353,7 @@ func (c *Client) GetWorkflowExecutionStatisticsForTemplates(workflowTemplates ..
 		COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND phase = 'Succeeded') completed,
 		COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND (phase = 'Failed' OR phase = 'Error')) failed,
 		COUNT(*) FILTER (WHERE phase = 'Terminated') terminated,
+		COUNT(*) FILTER (WHERE phase = 'Suspended') suspended,
 		COUNT(*) total`
 
 	query, args, err := sb.Select(statsSelect).
@@ -0,0 +1,292 @@
+package v1
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultLogSearchLimit is the maximum number of results of a log search if no limit is requested
+const defaultLogSearchLimit = 1000
+
+// maxLogLineSize is the longest log line that can be read when searching logs
+const maxLogLineSize = 1024 * 1024
+
+// archivedLogContainerName is the container whose log is saved to the artifact repository when a pod completes
+const archivedLogContainerName = "main"
+
+// getWorkflowExecutionLogSources returns the container logs of all the pods of the workflow execution, ordered by the time the pods started.
+// Completed pods only have the log of the main container, as it is the only one saved to the artifact repository.
+// If containers is not empty, only logs of these containers are returned.
+func (c *Client) getWorkflowExecutionLogSources(namespace string, wf *wfv1.Workflow, containers []string) []*workflowExecutionLogSource {
+	nodes := make([]wfv1.NodeStatus, 0)
+	for _, node := range wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypePod {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if !nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+		}
+
+		return nodes[i].ID < nodes[j].ID
+	})
+
+	allowedContainers := make(map[string]bool)
+	for _, container := range containers {
+		allowedContainers[container] = true
+	}
+
+	sources := make([]*workflowExecutionLogSource, 0)
+	for _, node := range nodes {
+		containerNames := []string{archivedLogContainerName}
+		if !node.Completed() {
+			pod, err := c.CoreV1().Pods(namespace).Get(node.ID, metav1.GetOptions{})
+			if err != nil {
+				continue
+			}
+
+			containerNames = make([]string, 0)
+			for _, container := range pod.Spec.Containers {
+				containerNames = append(containerNames, container.Name)
+			}
+		}
+
+		for _, containerName := range containerNames {
+			if len(allowedContainers) != 0 && !allowedContainers[containerName] {
+				continue
+			}
+
+			sources = append(sources, &workflowExecutionLogSource{
+				PodName:       node.ID,
+				NodeName:      node.DisplayName,
+				ContainerName: containerName,
+				Completed:     node.Completed(),
+			})
+		}
+	}
+
+	return sources
+}
+
+// getWorkflowExecutionForLogs returns the argo workflow of the workflow execution or a NotFound error
+func (c *Client) getWorkflowExecutionForLogs(namespace, uid string) (*wfv1.Workflow, error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	return wf, nil
+}
+
+// searchLog returns the entries of the log that match, up to limit entries
+func searchLog(stream io.Reader, source *workflowExecutionLogSource, matcher *logEntryMatcher, limit int) ([]*WorkflowExecutionLogMatch, error) {
+	matches := make([]*WorkflowExecutionLogMatch, 0)
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 4096), maxLogLineSize)
+	lineNumber := 0
+	for scanner.Scan() && len(matches) < limit {
+		lineNumber++
+
+		line := scanner.Text()
+		entry := LogEntryFromLine(&line)
+		if entry == nil || !matcher.Matches(entry) {
+			continue
+		}
+
+		matches = append(matches, &WorkflowExecutionLogMatch{
+			PodName:       source.PodName,
+			NodeName:      source.NodeName,
+			ContainerName: source.ContainerName,
+			Line:          lineNumber,
+			LogEntry:      *entry,
+		})
+	}
+
+	return matches, scanner.Err()
+}
+
+// SearchWorkflowExecutionLogs searches the logs of every container of every pod of the workflow execution.
+// Live pods are read from kubernetes and completed pods from the artifact repository.
+// Logs that can not be read, e.g. because the pod was deleted, are skipped.
+func (c *Client) SearchWorkflowExecutionLogs(namespace, uid string, search *WorkflowExecutionLogSearch) (matches []*WorkflowExecutionLogMatch, err error) {
+	matcher, err := newLogEntryMatcher(search)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Invalid pattern: "+err.Error())
+	}
+
+	limit := search.Limit
+	if limit <= 0 {
+		limit = defaultLogSearchLimit
+	}
+
+	wf, err := c.getWorkflowExecutionForLogs(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	matches = make([]*WorkflowExecutionLogMatch, 0)
+	for _, source := range c.getWorkflowExecutionLogSources(namespace, wf, search.Containers) {
+		stream, err := c.openWorkflowExecutionLog(namespace, uid, source.PodName, source.ContainerName, source.Completed, false)
+		if err != nil {
+			continue
+		}
+
+		sourceMatches, err := searchLog(stream, source, matcher, limit-len(matches))
+		stream.Close()
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":     namespace,
+				"UID":           uid,
+				"PodName":       source.PodName,
+				"ContainerName": source.ContainerName,
+				"Error":         err.Error(),
+			}).Error("Error reading log.")
+		}
+
+		matches = append(matches, sourceMatches...)
+		if len(matches) >= limit {
+			break
+		}
+	}
+
+	return matches, nil
+}
+
+// logArchiveWriter adds files to an archive
+type logArchiveWriter interface {
+	AddFile(name string, content []byte) error
+	Close() error
+}
+
+// zipLogArchiveWriter writes a zip archive
+type zipLogArchiveWriter struct {
+	writer *zip.Writer
+}
+
+// AddFile adds a file to the zip archive
+func (z *zipLogArchiveWriter) AddFile(name string, content []byte) error {
+	file, err := z.writer.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(content)
+	return err
+}
+
+// Close finishes writing the zip archive
+func (z *zipLogArchiveWriter) Close() error {
+	return z.writer.Close()
+}
+
+// tarGzLogArchiveWriter writes a gzip compressed tar archive
+type tarGzLogArchiveWriter struct {
+	gzipWriter *gzip.Writer
+	tarWriter  *tar.Writer
+}
+
+// AddFile adds a file to the tar archive
+func (t *tarGzLogArchiveWriter) AddFile(name string, content []byte) error {
+	err := t.tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(content)),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = t.tarWriter.Write(content)
+	return err
+}
+
+// Close finishes writing the tar archive and its compression
+func (t *tarGzLogArchiveWriter) Close() error {
+	if err := t.tarWriter.Close(); err != nil {
+		return err
+	}
+
+	return t.gzipWriter.Close()
+}
+
+// newLogArchiveWriter creates a writer for the archive format that writes to w
+func newLogArchiveWriter(w io.Writer, format LogArchiveFormat) logArchiveWriter {
+	if format == LogArchiveFormatTarGz {
+		gzipWriter := gzip.NewWriter(w)
+		return &tarGzLogArchiveWriter{
+			gzipWriter: gzipWriter,
+			tarWriter:  tar.NewWriter(gzipWriter),
+		}
+	}
+
+	return &zipLogArchiveWriter{
+		writer: zip.NewWriter(w),
+	}
+}
+
+// DownloadWorkflowExecutionLogs returns an archive with the log of every container of every pod of the workflow execution.
+// Each log is in a file named <pod name>/<container name>.log.
+// Logs that can not be read, e.g. because the pod was deleted, are skipped.
+func (c *Client) DownloadWorkflowExecutionLogs(namespace, uid string, format LogArchiveFormat) (archive []byte, err error) {
+	wf, err := c.getWorkflowExecutionForLogs(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := newLogArchiveWriter(buffer, format)
+	for _, source := range c.getWorkflowExecutionLogSources(namespace, wf, nil) {
+		stream, err := c.openWorkflowExecutionLog(namespace, uid, source.PodName, source.ContainerName, source.Completed, false)
+		if err != nil {
+			continue
+		}
+
+		content, err := ioutil.ReadAll(stream)
+		stream.Close()
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":     namespace,
+				"UID":           uid,
+				"PodName":       source.PodName,
+				"ContainerName": source.ContainerName,
+				"Error":         err.Error(),
+			}).Error("Error reading log.")
+			continue
+		}
+
+		if err := writer.AddFile(source.PodName+"/"+source.ContainerName+".log", content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
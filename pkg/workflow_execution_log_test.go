@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_searchLog tests that log entries are filtered by pattern and time range
+func Test_searchLog(t *testing.T) {
+	logs := strings.Join([]string{
+		"2020-01-01T00:00:00Z starting",
+		"2020-01-01T00:01:00Z error: out of memory",
+		"2020-01-01T00:02:00Z error: retrying",
+		"no timestamp error",
+	}, "\n")
+
+	source := &workflowExecutionLogSource{PodName: "pod", ContainerName: "main"}
+
+	matcher, err := newLogEntryMatcher(&WorkflowExecutionLogSearch{Pattern: "^error"})
+	assert.Nil(t, err)
+	matches, err := searchLog(strings.NewReader(logs), source, matcher, 10)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 2)
+	assert.Equal(t, 2, matches[0].Line)
+	assert.Equal(t, "error: out of memory", matches[0].Content)
+
+	since := time.Date(2020, 1, 1, 0, 1, 30, 0, time.UTC)
+	matcher, err = newLogEntryMatcher(&WorkflowExecutionLogSearch{Pattern: "error", Since: &since})
+	assert.Nil(t, err)
+	matches, err = searchLog(strings.NewReader(logs), source, matcher, 10)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, 3, matches[0].Line)
+
+	matcher, err = newLogEntryMatcher(&WorkflowExecutionLogSearch{})
+	assert.Nil(t, err)
+	matches, err = searchLog(strings.NewReader(logs), source, matcher, 3)
+	assert.Nil(t, err)
+	assert.Len(t, matches, 3)
+
+	_, err = newLogEntryMatcher(&WorkflowExecutionLogSearch{Pattern: "("})
+	assert.NotNil(t, err)
+}
+
+// Test_newLogArchiveWriter_Zip tests that files added to a zip archive can be read back
+func Test_newLogArchiveWriter_Zip(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer := newLogArchiveWriter(buffer, LogArchiveFormatZip)
+	assert.Nil(t, writer.AddFile("pod/main.log", []byte("hello")))
+	assert.Nil(t, writer.Close())
+
+	reader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	assert.Nil(t, err)
+	assert.Len(t, reader.File, 1)
+	assert.Equal(t, "pod/main.log", reader.File[0].Name)
+}
@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// LogArchiveFormat is the format of an archive of workflow execution logs
+type LogArchiveFormat string
+
+const (
+	// LogArchiveFormatZip is a zip archive
+	LogArchiveFormatZip LogArchiveFormat = "zip"
+	// LogArchiveFormatTarGz is a gzip compressed tar archive
+	LogArchiveFormatTarGz LogArchiveFormat = "tar.gz"
+)
+
+// ParseLogArchiveFormat returns the LogArchiveFormat for value. An empty value is LogArchiveFormatZip.
+func ParseLogArchiveFormat(value string) (LogArchiveFormat, error) {
+	switch LogArchiveFormat(value) {
+	case "", LogArchiveFormatZip:
+		return LogArchiveFormatZip, nil
+	case LogArchiveFormatTarGz, "tgz":
+		return LogArchiveFormatTarGz, nil
+	}
+
+	return "", fmt.Errorf("unknown log archive format '%v'", value)
+}
+
+// ContentType returns the mime type of the archive format
+func (f LogArchiveFormat) ContentType() string {
+	if f == LogArchiveFormatTarGz {
+		return "application/gzip"
+	}
+
+	return "application/zip"
+}
+
+// WorkflowExecutionLogSearch are the criteria used to search the logs of a workflow execution
+type WorkflowExecutionLogSearch struct {
+	// Pattern is a regular expression the content of a log entry has to match. Empty matches everything.
+	Pattern string
+	// Since and Until limit the results to log entries in the time range. Entries without a timestamp are then excluded.
+	Since *time.Time
+	Until *time.Time
+	// Containers limits the search to these containers. Empty searches all containers.
+	Containers []string
+	// Limit is the maximum number of results
+	Limit int
+}
+
+// WorkflowExecutionLogMatch is a log entry that matched a WorkflowExecutionLogSearch
+type WorkflowExecutionLogMatch struct {
+	PodName       string
+	NodeName      string
+	ContainerName string
+	// Line is the line number of the entry in the container log, starting at 1
+	Line int
+	LogEntry
+}
+
+// workflowExecutionLogSource is the log of a container of a workflow execution pod
+type workflowExecutionLogSource struct {
+	PodName       string
+	NodeName      string
+	ContainerName string
+	Completed     bool
+}
+
+// logEntryMatcher tests log entries against the criteria of a WorkflowExecutionLogSearch
+type logEntryMatcher struct {
+	pattern *regexp.Regexp
+	since   *time.Time
+	until   *time.Time
+}
+
+// newLogEntryMatcher creates a matcher for the search, the pattern has to be a valid regular expression
+func newLogEntryMatcher(search *WorkflowExecutionLogSearch) (*logEntryMatcher, error) {
+	matcher := &logEntryMatcher{
+		since: search.Since,
+		until: search.Until,
+	}
+
+	if search.Pattern != "" {
+		pattern, err := regexp.Compile(search.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		matcher.pattern = pattern
+	}
+
+	return matcher, nil
+}
+
+// Matches returns true if the entry matches the pattern and is in the time range
+func (m *logEntryMatcher) Matches(entry *LogEntry) bool {
+	if m.since != nil || m.until != nil {
+		if entry.Timestamp.IsZero() {
+			return false
+		}
+		if m.since != nil && entry.Timestamp.Before(*m.since) {
+			return false
+		}
+		if m.until != nil && entry.Timestamp.After(*m.until) {
+			return false
+		}
+	}
+
+	if m.pattern != nil && !m.pattern.MatchString(entry.Content) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,210 @@
+package v1
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// workflowExecutionMetricsRow is the id and metrics summary of a workflow execution
+type workflowExecutionMetricsRow struct {
+	ID      uint64
+	Metrics Metrics
+}
+
+// getWorkflowExecutionMetricsRow returns the id and metrics summary of the workflow execution, or a NotFound error
+func (c *Client) getWorkflowExecutionMetricsRow(namespace, uid string) (*workflowExecutionMetricsRow, error) {
+	return getWorkflowExecutionMetricsRow(c.DB, namespace, uid, false)
+}
+
+// getWorkflowExecutionMetricsRow returns the id and metrics summary of the workflow execution using the runner, or a NotFound error.
+// If forUpdate is true, the row is locked until the transaction of the runner ends, so concurrent updates of the summary wait for each other.
+func getWorkflowExecutionMetricsRow(runner sqlx.Queryer, namespace, uid string, forUpdate bool) (*workflowExecutionMetricsRow, error) {
+	query := sb.Select("id", "metrics").
+		From("workflow_executions").
+		Where(sq.Eq{
+			"namespace":   namespace,
+			"uid":         uid,
+			"is_archived": false,
+		})
+	if forUpdate {
+		query = query.Suffix("FOR UPDATE")
+	}
+
+	querySQL, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	row := &workflowExecutionMetricsRow{}
+	if err := sqlx.Get(runner, row, querySQL, args...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Workflow execution not found")
+		}
+
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// aggregateMetricPointsQuery returns a query that summarizes the points of the named metrics of a workflow execution
+func aggregateMetricPointsQuery(workflowExecutionID uint64, names []string, aggregation MetricAggregation) sq.SelectBuilder {
+	whereMap := sq.Eq{
+		"workflow_execution_id": workflowExecutionID,
+		"name":                  names,
+	}
+
+	if aggregation == MetricAggregationLast {
+		return sb.Select("name", "value", "format").
+			Options("DISTINCT ON (name)").
+			From("workflow_execution_metrics").
+			Where(whereMap).
+			OrderBy("name", "step desc", "created_at desc", "id desc")
+	}
+
+	valueColumn := "MAX(value) AS value"
+	if aggregation == MetricAggregationMin {
+		valueColumn = "MIN(value) AS value"
+	}
+
+	return sb.Select("name", valueColumn, "MAX(format) AS format").
+		From("workflow_execution_metrics").
+		Where(whereMap).
+		GroupBy("name")
+}
+
+// AppendWorkflowExecutionMetricPoints adds points to the metrics history of the workflow execution.
+// The metrics that received points are then summarized using aggregation and replace the ones in the Metrics of the workflow execution.
+// The workflow execution is locked while its Metrics are updated, so concurrent appends do not overwrite each other's summaries.
+// Points without a time are recorded at the current time.
+func (c *Client) AppendWorkflowExecutionMetricPoints(namespace, uid string, points []*MetricPoint, aggregation MetricAggregation) (metrics Metrics, err error) {
+	if len(points) == 0 {
+		return nil, util.NewUserError(codes.InvalidArgument, "At least one metric point is required.")
+	}
+
+	tx, err := c.DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	row, err := getWorkflowExecutionMetricsRow(tx, namespace, uid, true)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	names := make([]string, 0)
+	seenNames := make(map[string]bool)
+	insert := sb.Insert("workflow_execution_metrics").
+		Columns("workflow_execution_id", "name", "step", "value", "format", "created_at")
+	for _, point := range points {
+		if point.Name == "" {
+			return nil, util.NewUserError(codes.InvalidArgument, "Metric point name is required.")
+		}
+
+		createdAt := point.CreatedAt.UTC()
+		if point.CreatedAt.IsZero() {
+			createdAt = now
+		}
+
+		insert = insert.Values(row.ID, point.Name, point.Step, point.Value, point.Format, createdAt)
+
+		if !seenNames[point.Name] {
+			seenNames[point.Name] = true
+			names = append(names, point.Name)
+		}
+	}
+
+	if _, err := insert.RunWith(tx).Exec(); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to insert metric points.")
+		return nil, util.NewUserError(codes.Internal, "Error adding metric points.")
+	}
+
+	query, args, err := aggregateMetricPointsQuery(row.ID, names, aggregation).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	aggregated := make([]*Metric, 0)
+	if err := tx.Select(&aggregated, query, args...); err != nil {
+		return nil, err
+	}
+
+	row.Metrics.Merge(aggregated, true)
+
+	_, err = sb.Update("workflow_executions").
+		Set("metrics", row.Metrics).
+		Where(sq.Eq{"id": row.ID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, util.NewUserError(codes.Internal, "Error updating metrics.")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return row.Metrics, nil
+}
+
+// ListWorkflowExecutionMetricSeries returns the metrics history of the workflow execution, one series per metric name.
+// If names is not empty, only those metrics are returned.
+// If maxPoints is positive, each series is downsampled to at most maxPoints points.
+func (c *Client) ListWorkflowExecutionMetricSeries(namespace, uid string, names []string, maxPoints int) (series []*MetricSeries, err error) {
+	row, err := c.getWorkflowExecutionMetricsRow(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	whereMap := sq.Eq{
+		"workflow_execution_id": row.ID,
+	}
+	if len(names) != 0 {
+		whereMap["name"] = names
+	}
+
+	query := sb.Select(getMetricPointColumns()...).
+		From("workflow_execution_metrics").
+		Where(whereMap).
+		OrderBy("name", "step", "created_at", "id")
+
+	points := make([]*MetricPoint, 0)
+	if err := c.DB.Selectx(&points, query); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to get metric points.")
+		return nil, util.NewUserError(codes.Internal, "Error getting metric points.")
+	}
+
+	series = make([]*MetricSeries, 0)
+	for start := 0; start < len(points); {
+		end := start
+		for end < len(points) && points[end].Name == points[start].Name {
+			end++
+		}
+
+		series = append(series, &MetricSeries{
+			Name:   points[start].Name,
+			Format: points[end-1].Format,
+			Points: downsampleMetricPoints(points[start:end], maxPoints),
+		})
+
+		start = end
+	}
+
+	return series, nil
+}
@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/sql"
+)
+
+// MetricAggregation is how the points of a metric are summarized into the Metrics of a workflow execution
+type MetricAggregation string
+
+const (
+	// MetricAggregationLast uses the value of the point with the highest step
+	MetricAggregationLast MetricAggregation = "last"
+	// MetricAggregationMin uses the lowest value of all the points
+	MetricAggregationMin MetricAggregation = "min"
+	// MetricAggregationMax uses the highest value of all the points
+	MetricAggregationMax MetricAggregation = "max"
+)
+
+// ParseMetricAggregation returns the MetricAggregation for value. An empty value is MetricAggregationLast.
+func ParseMetricAggregation(value string) (MetricAggregation, error) {
+	switch MetricAggregation(value) {
+	case "", MetricAggregationLast:
+		return MetricAggregationLast, nil
+	case MetricAggregationMin:
+		return MetricAggregationMin, nil
+	case MetricAggregationMax:
+		return MetricAggregationMax, nil
+	}
+
+	return "", fmt.Errorf("unknown metric aggregation '%v'", value)
+}
+
+// MetricPoint is the value of a metric at a step, e.g. an epoch, of a workflow execution
+type MetricPoint struct {
+	ID        uint64
+	Name      string
+	Step      int64
+	Value     float64
+	Format    string
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// MetricSeries is the history of a metric of a workflow execution, ordered by step
+type MetricSeries struct {
+	Name   string
+	Format string
+	Points []*MetricPoint
+}
+
+// getMetricPointColumns returns all of the columns for workflow_execution_metrics modified by alias, destination.
+// see formatColumnSelect
+func getMetricPointColumns(aliasAndDestination ...string) []string {
+	columns := []string{
+		"id",
+		"name",
+		"step",
+		"value",
+		"format",
+		"created_at",
+	}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// downsampleMetricPoints reduces points to at most maxPoints by splitting them in buckets of consecutive points.
+// Each bucket is replaced by a point with the average value and the step and time of the last point in the bucket.
+// The points are returned as is if maxPoints is not positive.
+func downsampleMetricPoints(points []*MetricPoint, maxPoints int) []*MetricPoint {
+	if maxPoints <= 0 || len(points) <= maxPoints {
+		return points
+	}
+
+	bucketSize := (len(points) + maxPoints - 1) / maxPoints
+	result := make([]*MetricPoint, 0, maxPoints)
+	for start := 0; start < len(points); start += bucketSize {
+		end := start + bucketSize
+		if end > len(points) {
+			end = len(points)
+		}
+
+		last := points[end-1]
+		sum := 0.0
+		for _, point := range points[start:end] {
+			sum += point.Value
+		}
+
+		result = append(result, &MetricPoint{
+			ID:        last.ID,
+			Name:      last.Name,
+			Step:      last.Step,
+			Value:     sum / float64(end-start),
+			Format:    last.Format,
+			CreatedAt: last.CreatedAt,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseMetricAggregation tests parsing metric aggregations, including the default
+func TestParseMetricAggregation(t *testing.T) {
+	aggregation, err := ParseMetricAggregation("")
+	assert.Nil(t, err)
+	assert.Equal(t, MetricAggregationLast, aggregation)
+
+	aggregation, err = ParseMetricAggregation("min")
+	assert.Nil(t, err)
+	assert.Equal(t, MetricAggregationMin, aggregation)
+
+	_, err = ParseMetricAggregation("avg")
+	assert.NotNil(t, err)
+}
+
+// Test_downsampleMetricPoints tests that buckets are averaged and keep the step of their last point
+func Test_downsampleMetricPoints(t *testing.T) {
+	points := make([]*MetricPoint, 0)
+	for i := 1; i <= 5; i++ {
+		points = append(points, &MetricPoint{
+			Name:  "loss",
+			Step:  int64(i),
+			Value: float64(i),
+		})
+	}
+
+	assert.Equal(t, points, downsampleMetricPoints(points, 0))
+	assert.Equal(t, points, downsampleMetricPoints(points, 5))
+
+	result := downsampleMetricPoints(points, 2)
+	assert.Len(t, result, 2)
+	assert.Equal(t, int64(3), result[0].Step)
+	assert.Equal(t, 2.0, result[0].Value)
+	assert.Equal(t, int64(5), result[1].Step)
+	assert.Equal(t, 4.5, result[1].Value)
+}
@@ -1,8 +1,13 @@
 package v1
 
 import (
-	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // TestClient_CreateWorkflowExecution tests creating a workflow execution
@@ -97,3 +102,107 @@ func TestClient_ArchiveWorkflowExecution_Exist(t *testing.T) {
 	err = c.ArchiveWorkflowExecution(namespace, weName)
 	assert.Nil(t, err)
 }
+
+// Test_newWorkflowExecutionGraph tests that edges, retries and artifact keys are resolved from the workflow status
+func Test_newWorkflowExecutionGraph(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	finish := start.Add(time.Minute)
+
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "onepanel",
+		},
+		Status: wfv1.WorkflowStatus{
+			Phase:      wfv1.NodeSucceeded,
+			StartedAt:  metav1.NewTime(start),
+			FinishedAt: metav1.NewTime(finish),
+			Nodes: map[string]wfv1.NodeStatus{
+				"test": {
+					ID:        "test",
+					Type:      wfv1.NodeTypeRetry,
+					Phase:     wfv1.NodeSucceeded,
+					StartedAt: metav1.NewTime(start),
+					Children:  []string{"test-1", "test-2"},
+				},
+				"test-1": {
+					ID:        "test-1",
+					Type:      wfv1.NodeTypePod,
+					Phase:     wfv1.NodeFailed,
+					StartedAt: metav1.NewTime(start.Add(time.Second)),
+				},
+				"test-2": {
+					ID:         "test-2",
+					Type:       wfv1.NodeTypePod,
+					Phase:      wfv1.NodeSucceeded,
+					StartedAt:  metav1.NewTime(start.Add(2 * time.Second)),
+					FinishedAt: metav1.NewTime(finish),
+					Outputs: &wfv1.Outputs{
+						Artifacts: wfv1.Artifacts{
+							{Name: "model", Path: "/mnt/output"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	repository := &ArtifactRepositoryProvider{
+		S3: &ArtifactRepositoryS3Provider{
+			Bucket:    "bucket",
+			KeyFormat: "artifacts/{{workflow.namespace}}/{{workflow.name}}/{{pod.name}}",
+		},
+	}
+
+	graph := newWorkflowExecutionGraph(wf, repository)
+	assert.Equal(t, time.Minute, graph.Duration)
+	assert.Len(t, graph.Nodes, 3)
+
+	assert.Equal(t, "test", graph.Nodes[0].ID)
+	assert.Equal(t, 1, graph.Nodes[0].Retries)
+	assert.Equal(t, []string{"test"}, graph.Nodes[2].Parents)
+
+	node := graph.Nodes[2]
+	assert.Equal(t, "test-2", node.ID)
+	assert.Len(t, node.OutputArtifacts, 1)
+	assert.Equal(t, "bucket", node.OutputArtifacts[0].Bucket)
+	assert.Equal(t, "artifacts/onepanel/test/test-2/model.tgz", node.OutputArtifacts[0].Key)
+}
+
+// Test_compareWorkflowExecutions tests that differing parameters and the best metrics are found
+func Test_compareWorkflowExecutions(t *testing.T) {
+	workflowExecutions := []*WorkflowExecution{
+		{
+			UID: "a",
+			Parameters: []Parameter{
+				{Name: "epochs", Value: ptr.String("10")},
+				{Name: "batch-size", Value: ptr.String("32")},
+			},
+			Metrics: Metrics{
+				{Name: "accuracy", Value: 0.8},
+				{Name: "loss", Value: 0.4},
+			},
+		},
+		{
+			UID: "b",
+			Parameters: []Parameter{
+				{Name: "epochs", Value: ptr.String("20")},
+				{Name: "batch-size", Value: ptr.String("32")},
+			},
+			Metrics: Metrics{
+				{Name: "accuracy", Value: 0.9},
+				{Name: "loss", Value: 0.2},
+			},
+		},
+	}
+
+	comparison := compareWorkflowExecutions(workflowExecutions, map[string]bool{"loss": true})
+	assert.Len(t, comparison.Parameters, 2)
+	assert.True(t, comparison.Parameters[0].Differs)
+	assert.False(t, comparison.Parameters[1].Differs)
+
+	assert.Len(t, comparison.Metrics, 2)
+	assert.Equal(t, "b", comparison.Metrics[0].BestUID)
+	assert.Equal(t, "b", comparison.Metrics[1].BestUID)
+	assert.True(t, comparison.Metrics[1].Differs)
+}
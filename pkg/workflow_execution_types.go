@@ -9,6 +9,10 @@ import (
 	"time"
 )
 
+// WorkflowExecutionSuspended is the phase of a suspended workflow execution in the database.
+// Argo keeps the phase of a suspended workflow as Running.
+const WorkflowExecutionSuspended wfv1.NodePhase = "Suspended"
+
 // WorkflowExecution represents a workflow that is either in execution, or finished/failed.
 type WorkflowExecution struct {
 	ID               uint64
@@ -147,3 +151,83 @@ func getWorkflowExecutionColumnsMap(camelCase bool) map[string]string {
 
 	return result
 }
+
+// WorkflowExecutionArtifact is an input or output artifact of a workflow execution node
+// Bucket and Key are resolved against the artifact repository of the namespace
+type WorkflowExecutionArtifact struct {
+	Name   string
+	Path   string
+	Bucket string
+	Key    string
+}
+
+// WorkflowExecutionNode is a normalized node of a workflow execution graph
+type WorkflowExecutionNode struct {
+	ID              string
+	Name            string
+	DisplayName     string
+	Type            wfv1.NodeType
+	TemplateName    string
+	Phase           wfv1.NodePhase
+	Message         string
+	Parents         []string
+	Children        []string
+	StartedAt       *time.Time
+	FinishedAt      *time.Time
+	Duration        time.Duration
+	Retries         int
+	Inputs          []Parameter
+	Outputs         []Parameter
+	InputArtifacts  []WorkflowExecutionArtifact
+	OutputArtifacts []WorkflowExecutionArtifact
+}
+
+// WorkflowExecutionGraph is the graph of nodes of a workflow execution
+type WorkflowExecutionGraph struct {
+	UID        string
+	Phase      wfv1.NodePhase
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+	Duration   time.Duration
+	Nodes      []*WorkflowExecutionNode
+}
+
+// WorkflowExecutionParameterComparison holds the values of a parameter for each compared workflow execution
+// A nil value means the workflow execution does not have the parameter
+type WorkflowExecutionParameterComparison struct {
+	Name    string
+	Values  []*string
+	Differs bool
+}
+
+// WorkflowExecutionMetricComparison holds the values of a metric for each compared workflow execution
+// A nil value means the workflow execution does not have the metric
+type WorkflowExecutionMetricComparison struct {
+	Name    string
+	Format  string
+	Values  []*float64
+	Differs bool
+	// BestUID is the uid of the workflow execution with the best value for the metric
+	BestUID string
+}
+
+// WorkflowExecutionComparison compares workflow executions side by side.
+// Values in the parameters and metrics are in the same order as WorkflowExecutions.
+type WorkflowExecutionComparison struct {
+	WorkflowExecutions []*WorkflowExecution
+	Parameters         []*WorkflowExecutionParameterComparison
+	Metrics            []*WorkflowExecutionMetricComparison
+}
+
+// Duration returns how long the workflow execution ran for. Running workflow executions report the time since they started.
+func (we *WorkflowExecution) Duration() time.Duration {
+	if we.StartedAt == nil || we.StartedAt.IsZero() {
+		return 0
+	}
+
+	if we.FinishedAt == nil || we.FinishedAt.IsZero() {
+		return time.Since(*we.StartedAt)
+	}
+
+	return we.FinishedAt.Sub(*we.StartedAt)
+}
@@ -530,17 +530,24 @@ func (c *Client) selectWorkflowTemplatesQuery(namespace string, request *request
 	sb = c.workflowTemplatesSelectBuilder(namespace).
 		Column("COUNT(wtv.*) versions, MAX(wtv.id) workflow_template_version_id").
 		Join("workflow_template_versions wtv ON wtv.workflow_template_id = wt.id").
-		GroupBy("wt.id", "wt.created_at", "wt.uid", "wt.name", "wt.is_archived").
-		OrderBy("wt.created_at DESC")
+		GroupBy("wt.id", "wt.created_at", "wt.uid", "wt.name", "wt.is_archived")
 
 	sb, err = applyWorkflowTemplateFilter(sb, request)
 	if err != nil {
 		return
 	}
 
-	sb = *request.ApplyPaginationToSelect(&sb)
+	paginatedSb, err := request.ApplyKeysetPaginationToSelect(&sb, workflowTemplatesKeysetColumns(request))
+	if err != nil {
+		return sb, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
 
-	return
+	return *paginatedSb, nil
+}
+
+// workflowTemplatesKeysetColumns returns the columns workflow templates are ordered by, most recently created first
+func workflowTemplatesKeysetColumns(request *request.Request) []pagination.KeysetColumn {
+	return request.KeysetColumns("wt", nil, nil)
 }
 
 // selectWorkflowTemplatesDB loads non-archived and non-system workflow templates from the database for the input namespace
@@ -558,7 +565,13 @@ func (c *Client) selectWorkflowTemplatesDB(namespace string, request *request.Re
 		"wt.is_system":   false,
 	})
 
-	err = c.DB.Selectx(&workflowTemplates, sb)
+	if err = c.DB.Selectx(&workflowTemplates, sb); err != nil {
+		return nil, err
+	}
+
+	if err = request.SetNextContinue(workflowTemplatesKeysetColumns(request), workflowTemplates); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
 
 	return
 }
@@ -573,7 +586,13 @@ func (c *Client) selectAllWorkflowTemplatesDB(namespace string, request *request
 		return nil, err
 	}
 
-	err = c.DB.Selectx(&workflowTemplates, sb)
+	if err = c.DB.Selectx(&workflowTemplates, sb); err != nil {
+		return nil, err
+	}
+
+	if err = request.SetNextContinue(workflowTemplatesKeysetColumns(request), workflowTemplates); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
 
 	return
 }
@@ -757,6 +776,160 @@ func (c *Client) UpdateWorkflowTemplateVersion(wtv *WorkflowTemplateVersion) err
 	return updateWorkflowTemplateVersionDB(c.DB, wtv)
 }
 
+// setLatestWorkflowTemplateVersionDB marks the version as the only latest version of the workflow template
+func setLatestWorkflowTemplateVersionDB(runner sq.BaseRunner, workflowTemplateID uint64, version int64) error {
+	_, err := sb.Update("workflow_template_versions").
+		Set("is_latest", sq.Expr("version = ?", version)).
+		Where(sq.Eq{
+			"workflow_template_id": workflowTemplateID,
+		}).
+		RunWith(runner).
+		Exec()
+
+	return err
+}
+
+// setLatestArgoWorkflowTemplate moves the latest label of the argo workflow templates of a workflow template to the version
+func (c *Client) setLatestArgoWorkflowTemplate(namespace, workflowTemplateUID string, version int64) error {
+	target, err := c.getArgoWorkflowTemplate(namespace, workflowTemplateUID, strconv.FormatInt(version, 10))
+	if err != nil {
+		return err
+	}
+
+	latest, err := c.getArgoWorkflowTemplate(namespace, workflowTemplateUID, "latest")
+	if err == nil && latest.Name != target.Name {
+		delete(latest.Labels, label.VersionLatest)
+		if _, err := c.ArgoprojV1alpha1().WorkflowTemplates(namespace).Update(latest); err != nil {
+			return err
+		}
+	}
+
+	target.Labels[label.VersionLatest] = "true"
+	_, err = c.ArgoprojV1alpha1().WorkflowTemplates(namespace).Update(target)
+
+	return err
+}
+
+// setLatestWorkflowTemplateVersion marks an existing version of the workflow template as latest in the database.
+// The labels of the workflow template are updated to the ones of the version.
+// Argo is updated with setLatestArgoWorkflowTemplate once the change is committed.
+func setLatestWorkflowTemplateVersion(runner sq.BaseRunner, workflowTemplateVersion *WorkflowTemplateVersion) error {
+	workflowTemplate := workflowTemplateVersion.WorkflowTemplate
+	if err := setLatestWorkflowTemplateVersionDB(runner, workflowTemplate.ID, workflowTemplateVersion.Version); err != nil {
+		return err
+	}
+
+	_, err := sb.Update("workflow_templates").
+		Set("labels", workflowTemplateVersion.Labels).
+		Where(sq.Eq{
+			"id": workflowTemplate.ID,
+		}).
+		RunWith(runner).
+		Exec()
+
+	return err
+}
+
+// restoreLatestWorkflowTemplateVersion marks the previous latest version of a workflow template as latest again,
+// in the database and argo, after argo could not be updated to a new latest version.
+// Errors are only logged, as the error of the failed update is the one returned to the caller.
+func (c *Client) restoreLatestWorkflowTemplateVersion(namespace string, previous *WorkflowTemplateVersion) {
+	if err := setLatestWorkflowTemplateVersion(c.DB, previous); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       previous.WorkflowTemplate.UID,
+			"Version":   previous.Version,
+			"Error":     err.Error(),
+		}).Error("Unable to restore latest workflow template version.")
+	}
+
+	if err := c.setLatestArgoWorkflowTemplate(namespace, previous.WorkflowTemplate.UID, previous.Version); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       previous.WorkflowTemplate.UID,
+			"Version":   previous.Version,
+			"Error":     err.Error(),
+		}).Error("Unable to restore latest argo workflow template.")
+	}
+}
+
+// getWorkflowTemplateVersionByUID returns a version of a workflow template that is not archived, with the workflow template loaded.
+func (c *Client) getWorkflowTemplateVersionByUID(namespace, uid string, version int64) (*WorkflowTemplateVersion, error) {
+	query := c.workflowTemplatesVersionSelectBuilder(namespace).
+		Columns(getWorkflowTemplateColumns("wt", "workflow_template")...).
+		Where(sq.Eq{
+			"wt.uid":         uid,
+			"wt.is_archived": false,
+			"wtv.version":    version,
+		})
+
+	workflowTemplateVersion := &WorkflowTemplateVersion{}
+	if err := c.DB.Getx(workflowTemplateVersion, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Workflow template version not found.")
+		}
+
+		return nil, err
+	}
+
+	return workflowTemplateVersion, nil
+}
+
+// SetLatestWorkflowTemplateVersion makes a previous version of the workflow template the latest one.
+// If clone is true, a new version is created with the manifest, labels and description of the version.
+// Otherwise, the version itself is marked as latest, so new executions use it.
+func (c *Client) SetLatestWorkflowTemplateVersion(namespace, uid string, version int64, clone bool) (*WorkflowTemplate, error) {
+	workflowTemplateVersion, err := c.getWorkflowTemplateVersionByUID(namespace, uid, version)
+	if err != nil {
+		return nil, err
+	}
+
+	if clone {
+		return c.CreateWorkflowTemplateVersion(namespace, &WorkflowTemplate{
+			UID:         uid,
+			Name:        workflowTemplateVersion.WorkflowTemplate.Name,
+			Manifest:    workflowTemplateVersion.Manifest,
+			Labels:      workflowTemplateVersion.Labels,
+			Description: workflowTemplateVersion.Description,
+		})
+	}
+
+	if !workflowTemplateVersion.IsLatest {
+		previous, err := c.getLatestWorkflowTemplateVersionDB(namespace, workflowTemplateVersion.WorkflowTemplate.Name)
+		if err != nil {
+			return nil, err
+		}
+		previous.WorkflowTemplate = workflowTemplateVersion.WorkflowTemplate
+
+		tx, err := c.DB.Begin()
+		if err != nil {
+			return nil, err
+		}
+		defer tx.Rollback()
+
+		if err := setLatestWorkflowTemplateVersion(tx, workflowTemplateVersion); err != nil {
+			return nil, err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+
+		if err := c.setLatestArgoWorkflowTemplate(namespace, uid, version); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Version":   version,
+				"Error":     err.Error(),
+			}).Error("Unable to set latest workflow template version.")
+			c.restoreLatestWorkflowTemplateVersion(namespace, previous)
+			return nil, util.NewUserError(codes.Internal, "Unable to set latest workflow template version.")
+		}
+	}
+
+	return c.GetWorkflowTemplate(namespace, uid, version)
+}
+
 // GetWorkflowTemplateRaw returns the WorkflowTemplate without any version information
 func (c *Client) GetWorkflowTemplateRaw(namespace, uid string) (workflowTemplate *WorkflowTemplate, err error) {
 	workflowTemplate, err = c.getWorkflowTemplateRaw(namespace, uid)
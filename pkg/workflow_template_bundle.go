@@ -0,0 +1,419 @@
+package v1
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/ghodss/yaml"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/data"
+	"github.com/onepanelio/core/pkg/util/mapping"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// manifestToNode parses a yaml manifest so it keeps its key order and comments when written to a bundle
+func manifestToNode(manifest string) (*yaml3.Node, error) {
+	node := &yaml3.Node{}
+	if err := yaml3.Unmarshal([]byte(manifest), node); err != nil {
+		return nil, err
+	}
+
+	if node.Kind == yaml3.DocumentNode && len(node.Content) == 1 {
+		return node.Content[0], nil
+	}
+
+	return node, nil
+}
+
+// listExportableWorkflowTemplateUIDs returns the uids of the workflow templates in the namespace that are not archived or system templates
+func (c *Client) listExportableWorkflowTemplateUIDs(namespace string) (uids []string, err error) {
+	query := sb.Select("wt.uid").
+		From("workflow_templates wt").
+		Where(sq.Eq{
+			"wt.namespace":   namespace,
+			"wt.is_archived": false,
+			"wt.is_system":   false,
+		}).
+		OrderBy("wt.name")
+
+	uids = make([]string, 0)
+	err = c.DB.Selectx(&uids, query)
+
+	return
+}
+
+// workflowTemplateBundleManifests returns the bundle manifests of a workflow template: its versions, oldest first,
+// followed by its cron workflows if includeCronWorkflows is true.
+func (c *Client) workflowTemplateBundleManifests(namespace, uid string, includeCronWorkflows bool) ([]*data.ManifestFile, error) {
+	versions, err := c.selectWorkflowTemplateVersionsDB(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Workflow template '%v' not found.", uid))
+	}
+
+	templateName := versions[0].WorkflowTemplate.Name
+
+	manifests := make([]*data.ManifestFile, 0)
+	for i := len(versions) - 1; i >= 0; i-- {
+		version := versions[i]
+
+		spec, err := manifestToNode(version.Manifest)
+		if err != nil {
+			return nil, err
+		}
+
+		action := "update"
+		if len(manifests) == 0 {
+			action = "create"
+		}
+
+		manifest := &data.ManifestFile{
+			Metadata: data.ManifestFileMetadata{
+				Name:    templateName,
+				Kind:    bundleKindWorkflow,
+				Version: uint64(version.Version),
+				Action:  action,
+				Labels:  version.Labels,
+			},
+			Spec: spec,
+		}
+		if version.Description != "" {
+			manifest.Metadata.Description = ptr.String(version.Description)
+		}
+
+		manifests = append(manifests, manifest)
+	}
+
+	if !includeCronWorkflows {
+		return manifests, nil
+	}
+
+	cronWorkflows := make([]*CronWorkflow, 0)
+	cronWorkflowsSb := c.cronWorkflowSelectBuilder(namespace, uid).
+		OrderBy("cw.created_at")
+	if err := c.DB.Selectx(&cronWorkflows, cronWorkflowsSb); err != nil {
+		return nil, err
+	}
+
+	for _, cronWorkflow := range cronWorkflows {
+		spec, err := manifestToNode(cronWorkflow.Manifest)
+		if err != nil {
+			return nil, err
+		}
+
+		manifests = append(manifests, &data.ManifestFile{
+			Metadata: data.ManifestFileMetadata{
+				Name:     cronWorkflow.Name,
+				Kind:     bundleKindCronWorkflow,
+				Version:  uint64(cronWorkflow.Version),
+				Labels:   cronWorkflow.Labels,
+				Template: ptr.String(templateName),
+			},
+			Spec: spec,
+		})
+	}
+
+	return manifests, nil
+}
+
+// ExportWorkflowTemplates returns a bundle with every version of the workflow templates identified by uids.
+// If uids is empty, all of the workflow templates of the namespace are exported, except system ones.
+// The bundle is a multi-document yaml file where each document is a data.ManifestFile.
+func (c *Client) ExportWorkflowTemplates(namespace string, uids []string, includeCronWorkflows bool) (bundle []byte, err error) {
+	if len(uids) == 0 {
+		uids, err = c.listExportableWorkflowTemplateUIDs(namespace)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Error":     err.Error(),
+			}).Error("Unable to list workflow templates.")
+			return nil, util.NewUserError(codes.Internal, "Unable to export workflow templates.")
+		}
+	}
+
+	manifests := make([]*data.ManifestFile, 0)
+	for _, uid := range uids {
+		templateManifests, err := c.workflowTemplateBundleManifests(namespace, uid, includeCronWorkflows)
+		if err != nil {
+			if _, ok := err.(*util.UserError); ok {
+				return nil, err
+			}
+
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Error":     err.Error(),
+			}).Error("Unable to export workflow template.")
+			return nil, util.NewUserError(codes.Internal, "Unable to export workflow templates.")
+		}
+
+		manifests = append(manifests, templateManifests...)
+	}
+
+	return data.ManifestFilesToBytes(manifests)
+}
+
+// workflowTemplateBundleEntry is a workflow template read from a bundle
+type workflowTemplateBundleEntry struct {
+	Name          string
+	Versions      []*data.ManifestFile
+	CronWorkflows []*data.ManifestFile
+}
+
+// parseWorkflowTemplateBundle groups the manifests of a bundle by workflow template, in the order the templates first appear.
+// Versions are sorted by version, oldest first.
+func parseWorkflowTemplateBundle(bundle []byte) ([]*workflowTemplateBundleEntry, error) {
+	manifests, err := data.ManifestFilesFromBytes(bundle)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bundle: %v", err)
+	}
+
+	entries := make([]*workflowTemplateBundleEntry, 0)
+	entriesByName := make(map[string]*workflowTemplateBundleEntry)
+	getEntry := func(name string) *workflowTemplateBundleEntry {
+		entry, ok := entriesByName[name]
+		if !ok {
+			entry = &workflowTemplateBundleEntry{Name: name}
+			entriesByName[name] = entry
+			entries = append(entries, entry)
+		}
+
+		return entry
+	}
+
+	for _, manifest := range manifests {
+		switch manifest.Metadata.Kind {
+		case bundleKindWorkflow:
+			if manifest.Metadata.Name == "" {
+				return nil, fmt.Errorf("workflow template name is required")
+			}
+
+			entry := getEntry(manifest.Metadata.Name)
+			entry.Versions = append(entry.Versions, manifest)
+		case bundleKindCronWorkflow:
+			if manifest.Metadata.Template == nil || *manifest.Metadata.Template == "" {
+				return nil, fmt.Errorf("cron workflow '%v' does not have a template", manifest.Metadata.Name)
+			}
+
+			entry := getEntry(*manifest.Metadata.Template)
+			entry.CronWorkflows = append(entry.CronWorkflows, manifest)
+		default:
+			return nil, fmt.Errorf("unsupported kind '%v'", manifest.Metadata.Kind)
+		}
+	}
+
+	for _, entry := range entries {
+		if len(entry.Versions) == 0 {
+			return nil, fmt.Errorf("workflow template '%v' of cron workflows is not in the bundle", entry.Name)
+		}
+
+		sort.SliceStable(entry.Versions, func(i, j int) bool {
+			return entry.Versions[i].Metadata.Version < entry.Versions[j].Metadata.Version
+		})
+	}
+
+	return entries, nil
+}
+
+// workflowTemplateFromManifestFile creates the workflow template of a version in a bundle
+func workflowTemplateFromManifestFile(manifest *data.ManifestFile) (*WorkflowTemplate, error) {
+	spec, err := manifest.SpecString()
+	if err != nil {
+		return nil, err
+	}
+
+	workflowTemplate := &WorkflowTemplate{
+		Name:     manifest.Metadata.Name,
+		Manifest: spec,
+		Labels:   manifest.Metadata.Labels,
+	}
+	if manifest.Metadata.Description != nil {
+		workflowTemplate.Description = *manifest.Metadata.Description
+	}
+
+	return workflowTemplate, nil
+}
+
+// cronWorkflowFromManifestFile creates the cron workflow of a bundle, with its name, running the given workflow template version.
+// The workflow spec stored with the cron workflow is only used for its parameters, the rest comes from the workflow template.
+func cronWorkflowFromManifestFile(manifest *data.ManifestFile, workflowTemplateUID string, version int64) (*CronWorkflow, error) {
+	spec, err := manifest.SpecString()
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, err := (&CronWorkflow{Manifest: spec}).GetParametersFromWorkflowSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	specMapping, err := mapping.NewFromYamlString(spec)
+	if err != nil {
+		return nil, err
+	}
+	delete(specMapping, "workflowSpec")
+
+	specYaml, err := specMapping.ToYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	specJSON, err := yaml.YAMLToJSON(specYaml)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CronWorkflow{
+		Name:     manifest.Metadata.Name,
+		Manifest: string(specJSON),
+		Labels:   manifest.Metadata.Labels,
+		WorkflowExecution: &WorkflowExecution{
+			WorkflowTemplate: &WorkflowTemplate{
+				UID:     workflowTemplateUID,
+				Version: version,
+			},
+			Parameters: parameters,
+		},
+	}, nil
+}
+
+// importWorkflowTemplate imports one workflow template of a bundle using the policy.
+// Nothing existing is archived before all of the imported versions are created, so a failed import keeps the existing template.
+func (c *Client) importWorkflowTemplate(namespace string, entry *workflowTemplateBundleEntry, policy WorkflowTemplateImportPolicy, includeCronWorkflows bool) (*WorkflowTemplateImportResult, error) {
+	result := &WorkflowTemplateImportResult{
+		Name:   entry.Name,
+		Action: WorkflowTemplateImportActionCreated,
+	}
+
+	existing, err := c.getWorkflowTemplateDB(namespace, entry.Name)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if err == sql.ErrNoRows {
+		existing = nil
+	}
+
+	// replacedCronWorkflows are the cron workflows of the existing template, by name, that the imported ones replace.
+	// Cron workflows are only replaced if the ones in the bundle are imported.
+	replacedCronWorkflows := make(map[string]*CronWorkflow)
+	if existing != nil {
+		result.UID = existing.UID
+
+		switch policy {
+		case WorkflowTemplateImportPolicySkip:
+			result.Action = WorkflowTemplateImportActionSkipped
+			return result, nil
+		case WorkflowTemplateImportPolicyNewVersion:
+			result.Action = WorkflowTemplateImportActionVersioned
+		case WorkflowTemplateImportPolicyOverwrite:
+			result.Action = WorkflowTemplateImportActionOverwritten
+
+			if !includeCronWorkflows {
+				break
+			}
+
+			cronWorkflows := make([]*CronWorkflow, 0)
+			if err := c.DB.Selectx(&cronWorkflows, c.cronWorkflowSelectBuilder(namespace, existing.UID)); err != nil {
+				return nil, err
+			}
+			for _, cronWorkflow := range cronWorkflows {
+				replacedCronWorkflows[cronWorkflow.Name] = cronWorkflow
+			}
+		}
+	}
+
+	// versions maps the version in the bundle to the one created in the namespace, so cron workflows run the same manifest
+	versions := make(map[uint64]int64)
+	for _, manifest := range entry.Versions {
+		workflowTemplate, err := workflowTemplateFromManifestFile(manifest)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Invalid workflow template '%v': %v", entry.Name, err))
+		}
+
+		if existing == nil {
+			existing, err = c.CreateWorkflowTemplate(namespace, workflowTemplate)
+		} else {
+			workflowTemplate.UID = existing.UID
+			existing, err = c.CreateWorkflowTemplateVersion(namespace, workflowTemplate)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.UID = existing.UID
+		result.Versions++
+		versions[manifest.Metadata.Version] = existing.Version
+	}
+
+	if includeCronWorkflows {
+		for _, manifest := range entry.CronWorkflows {
+			version, ok := versions[manifest.Metadata.Version]
+			if !ok {
+				version = existing.Version
+			}
+
+			cronWorkflow, err := cronWorkflowFromManifestFile(manifest, existing.UID, version)
+			if err != nil {
+				return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Invalid cron workflow '%v': %v", manifest.Metadata.Name, err))
+			}
+
+			// The replaced cron workflow with the same name is updated in place, so it keeps running if the update fails
+			if replaced, ok := replacedCronWorkflows[cronWorkflow.Name]; ok {
+				if _, err := c.UpdateCronWorkflow(namespace, replaced.UID, cronWorkflow); err != nil {
+					return nil, err
+				}
+				delete(replacedCronWorkflows, cronWorkflow.Name)
+			} else if _, err := c.CreateCronWorkflow(namespace, cronWorkflow); err != nil {
+				return nil, err
+			}
+			result.CronWorkflows++
+		}
+	}
+
+	for name := range replacedCronWorkflows {
+		if err := c.ArchiveCronWorkflow(namespace, name); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ImportWorkflowTemplates creates the workflow templates of a bundle created by ExportWorkflowTemplates.
+// Version numbers are not kept, each imported version is a new version created in the bundle order.
+// If includeCronWorkflows is true, the cron workflows in the bundle are created for the imported version they ran.
+// Templates are imported in order and the import stops at the first error.
+func (c *Client) ImportWorkflowTemplates(namespace string, bundle []byte, policy WorkflowTemplateImportPolicy, includeCronWorkflows bool) (results []*WorkflowTemplateImportResult, err error) {
+	entries, err := parseWorkflowTemplateBundle(bundle)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	results = make([]*WorkflowTemplateImportResult, 0)
+	for _, entry := range entries {
+		result, err := c.importWorkflowTemplate(namespace, entry, policy, includeCronWorkflows)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Name":      entry.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to import workflow template.")
+
+			if _, ok := err.(*util.UserError); ok {
+				return nil, err
+			}
+			return nil, util.NewUserError(codes.Internal, fmt.Sprintf("Unable to import workflow template '%v'.", entry.Name))
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/onepanelio/core/pkg/util/data"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_parseWorkflowTemplateBundle makes sure the versions and cron workflows of a bundle are grouped by workflow template
+func Test_parseWorkflowTemplateBundle(t *testing.T) {
+	spec, err := manifestToNode("entrypoint: main\n")
+	assert.Nil(t, err)
+
+	bundle, err := data.ManifestFilesToBytes([]*data.ManifestFile{
+		{
+			Metadata: data.ManifestFileMetadata{Name: "train", Kind: bundleKindWorkflow, Version: 2, Action: "update"},
+			Spec:     spec,
+		},
+		{
+			Metadata: data.ManifestFileMetadata{Name: "train", Kind: bundleKindWorkflow, Version: 1, Action: "create"},
+			Spec:     spec,
+		},
+		{
+			Metadata: data.ManifestFileMetadata{Name: "nightly", Kind: bundleKindCronWorkflow, Version: 1, Template: ptr.String("train")},
+			Spec:     spec,
+		},
+	})
+	assert.Nil(t, err)
+
+	entries, err := parseWorkflowTemplateBundle(bundle)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "train", entries[0].Name)
+	assert.Len(t, entries[0].Versions, 2)
+	assert.Equal(t, uint64(1), entries[0].Versions[0].Metadata.Version)
+	assert.Len(t, entries[0].CronWorkflows, 1)
+
+	specString, err := entries[0].Versions[0].SpecString()
+	assert.Nil(t, err)
+	assert.Equal(t, "entrypoint: main\n", specString)
+}
+
+// Test_parseWorkflowTemplateBundle_MissingTemplate makes sure a cron workflow of a template that is not in the bundle is rejected
+func Test_parseWorkflowTemplateBundle_MissingTemplate(t *testing.T) {
+	bundle, err := data.ManifestFilesToBytes([]*data.ManifestFile{
+		{
+			Metadata: data.ManifestFileMetadata{Name: "nightly", Kind: bundleKindCronWorkflow, Template: ptr.String("train")},
+		},
+	})
+	assert.Nil(t, err)
+
+	_, err = parseWorkflowTemplateBundle(bundle)
+	assert.NotNil(t, err)
+}
+
+// Test_cronWorkflowFromManifestFile makes sure a cron workflow of a bundle keeps its name and parameters, without its workflow spec
+func Test_cronWorkflowFromManifestFile(t *testing.T) {
+	manifests, err := data.ManifestFilesFromBytes([]byte(`metadata:
+  name: nightly
+  kind: CronWorkflow
+  template: train
+spec:
+  schedule: "0 0 * * *"
+  workflowSpec:
+    arguments:
+      parameters:
+      - name: epochs
+        value: "10"
+`))
+	assert.Nil(t, err)
+	assert.Len(t, manifests, 1)
+
+	cronWorkflow, err := cronWorkflowFromManifestFile(manifests[0], "train", 42)
+	assert.Nil(t, err)
+	assert.Equal(t, "nightly", cronWorkflow.Name)
+	assert.JSONEq(t, `{"schedule": "0 0 * * *"}`, cronWorkflow.Manifest)
+	assert.Equal(t, "train", cronWorkflow.WorkflowExecution.WorkflowTemplate.UID)
+	assert.Equal(t, int64(42), cronWorkflow.WorkflowExecution.WorkflowTemplate.Version)
+	assert.Len(t, cronWorkflow.WorkflowExecution.Parameters, 1)
+	assert.Equal(t, "10", *cronWorkflow.WorkflowExecution.Parameters[0].Value)
+}
@@ -0,0 +1,54 @@
+package v1
+
+import "fmt"
+
+const (
+	// bundleKindWorkflow is the kind of the bundle manifests that hold a workflow template version
+	bundleKindWorkflow = "Workflow"
+	// bundleKindCronWorkflow is the kind of the bundle manifests that hold a cron workflow of a workflow template
+	bundleKindCronWorkflow = "CronWorkflow"
+)
+
+// WorkflowTemplateImportPolicy decides what happens when an imported workflow template has the same name as an existing one
+type WorkflowTemplateImportPolicy string
+
+const (
+	// WorkflowTemplateImportPolicySkip leaves the existing workflow template as is
+	WorkflowTemplateImportPolicySkip WorkflowTemplateImportPolicy = "skip"
+	// WorkflowTemplateImportPolicyNewVersion adds the imported versions to the existing workflow template
+	WorkflowTemplateImportPolicyNewVersion WorkflowTemplateImportPolicy = "new_version"
+	// WorkflowTemplateImportPolicyOverwrite makes the imported versions the latest versions of the existing workflow template
+	// and replaces its cron workflows with the imported ones
+	WorkflowTemplateImportPolicyOverwrite WorkflowTemplateImportPolicy = "overwrite"
+)
+
+// ParseWorkflowTemplateImportPolicy parses a policy. An empty value is the skip policy.
+func ParseWorkflowTemplateImportPolicy(value string) (WorkflowTemplateImportPolicy, error) {
+	switch WorkflowTemplateImportPolicy(value) {
+	case "", WorkflowTemplateImportPolicySkip:
+		return WorkflowTemplateImportPolicySkip, nil
+	case WorkflowTemplateImportPolicyNewVersion:
+		return WorkflowTemplateImportPolicyNewVersion, nil
+	case WorkflowTemplateImportPolicyOverwrite:
+		return WorkflowTemplateImportPolicyOverwrite, nil
+	}
+
+	return "", fmt.Errorf("unknown import policy '%v'", value)
+}
+
+// Actions taken for an imported workflow template
+const (
+	WorkflowTemplateImportActionCreated     = "created"
+	WorkflowTemplateImportActionSkipped     = "skipped"
+	WorkflowTemplateImportActionVersioned   = "versioned"
+	WorkflowTemplateImportActionOverwritten = "overwritten"
+)
+
+// WorkflowTemplateImportResult is the outcome of importing one workflow template of a bundle
+type WorkflowTemplateImportResult struct {
+	Name          string
+	UID           string
+	Action        string
+	Versions      int
+	CronWorkflows int
+}
@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/types"
+	uid2 "github.com/onepanelio/core/pkg/util/uid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// workflowTriggerSelectBuilder selects the triggers with the uid and version of their workflow template
+func workflowTriggerSelectBuilder() sq.SelectBuilder {
+	return sb.Select(getWorkflowTriggerColumns("t")...).
+		Columns(`wt.uid "workflow_template_uid"`, `wtv.version "workflow_template_version"`).
+		From("workflow_triggers t").
+		Join("workflow_template_versions wtv ON wtv.id = t.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id")
+}
+
+// CreateWorkflowTrigger creates a trigger that starts workflows from a version of a workflow template.
+// If the version is 0, the latest version of the workflow template is used.
+func (c *Client) CreateWorkflowTrigger(namespace string, trigger *WorkflowTrigger) (*WorkflowTrigger, error) {
+	// Keys in the artifact repository never start with a slash
+	trigger.Prefix = strings.TrimPrefix(trigger.Prefix, "/")
+
+	if err := trigger.validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	workflowTemplate, err := c.GetWorkflowTemplate(namespace, trigger.WorkflowTemplateUID, trigger.WorkflowTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	trigger.UID, err = uid2.GenerateUID(trigger.Name, 30)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+	trigger.Namespace = namespace
+	trigger.WorkflowTemplateVersionID = workflowTemplate.WorkflowTemplateVersionID
+	trigger.WorkflowTemplateVersion = workflowTemplate.Version
+
+	err = sb.Insert("workflow_triggers").
+		SetMap(sq.Eq{
+			"uid":                          trigger.UID,
+			"name":                         trigger.Name,
+			"namespace":                    trigger.Namespace,
+			"type":                         trigger.Type,
+			"workflow_template_version_id": trigger.WorkflowTemplateVersionID,
+			"parameters":                   trigger.Parameters,
+			"prefix":                       trigger.Prefix,
+			"pattern":                      trigger.Pattern,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&trigger.ID, &trigger.CreatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, util.NewUserError(codes.AlreadyExists, "Trigger already exists.")
+		}
+
+		return nil, err
+	}
+
+	return trigger, nil
+}
+
+// GetWorkflowTrigger returns the trigger of the namespace, or a NotFound error
+func (c *Client) GetWorkflowTrigger(namespace, uid string) (*WorkflowTrigger, error) {
+	query := workflowTriggerSelectBuilder().
+		Where(sq.Eq{
+			"t.namespace": namespace,
+			"t.uid":       uid,
+		})
+
+	trigger := &WorkflowTrigger{}
+	if err := c.DB.Getx(trigger, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Trigger not found.")
+		}
+
+		return nil, err
+	}
+
+	return trigger, nil
+}
+
+// ListWorkflowTriggers returns the triggers of the namespace, sorted by name
+func (c *Client) ListWorkflowTriggers(namespace string) (triggers []*WorkflowTrigger, err error) {
+	query := workflowTriggerSelectBuilder().
+		Where(sq.Eq{
+			"t.namespace": namespace,
+		}).
+		OrderBy("t.name", "t.id")
+
+	triggers = make([]*WorkflowTrigger, 0)
+	err = c.DB.Selectx(&triggers, query)
+
+	return
+}
+
+// DeleteWorkflowTrigger deletes the trigger. The workflows it started are not deleted.
+func (c *Client) DeleteWorkflowTrigger(namespace, uid string) error {
+	result, err := sb.Delete("workflow_triggers").
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return util.NewUserError(codes.NotFound, "Trigger not found.")
+	}
+
+	return nil
+}
+
+// ReceiveWorkflowTriggerWebhook starts a workflow for a request to the webhook of the trigger.
+// The request must be signed recently, see WorkflowTriggerSignature.
+func (c *Client) ReceiveWorkflowTriggerWebhook(request *WorkflowTriggerWebhookRequest) (*WorkflowExecution, error) {
+	config, err := c.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+	if err := request.Verify(config.HMACKey(), time.Now()); err != nil {
+		return nil, err
+	}
+
+	trigger, err := c.GetWorkflowTrigger(request.Namespace, request.UID)
+	if err != nil {
+		return nil, err
+	}
+	if trigger.Type != WorkflowTriggerWebhook {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Trigger is not a webhook.")
+	}
+
+	parameters, err := trigger.workflowParameters(request.Payload)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	return c.runWorkflowTrigger(trigger, parameters)
+}
+
+// runWorkflowTrigger starts a workflow from the workflow template version of the trigger, with the parameters
+func (c *Client) runWorkflowTrigger(trigger *WorkflowTrigger, parameters []Parameter) (*WorkflowExecution, error) {
+	workflowTemplate, err := c.GetWorkflowTemplate(trigger.Namespace, trigger.WorkflowTemplateUID, trigger.WorkflowTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// The values come from outside, e.g. the payload of a webhook request, so they are checked like the ones sent by users
+	if err := ValidateManifestParameters(workflowTemplate.Manifest, parameters); err != nil {
+		return nil, err
+	}
+
+	workflow, err := c.CreateWorkflowExecution(trigger.Namespace, &WorkflowExecution{
+		Parameters: parameters,
+		Labels: types.JSONLabels{
+			workflowTriggerLabelKey: trigger.UID,
+		},
+	}, workflowTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = sb.Update("workflow_triggers").
+		Set("last_triggered_at", time.Now().UTC()).
+		Where(sq.Eq{"id": trigger.ID}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": trigger.Namespace,
+			"Trigger":   trigger.UID,
+			"Error":     err.Error(),
+		}).Error("Unable to update last triggered time of trigger.")
+	}
+
+	return workflow, nil
+}
@@ -0,0 +1,226 @@
+package v1
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	log "github.com/sirupsen/logrus"
+)
+
+// listWorkflowTriggerObjects returns the objects of the artifact repository of the namespace that fire the artifact trigger.
+// Objects last modified before the previous poll are skipped, see WorkflowTrigger.objectsModifiedSince.
+func (c *Client) listWorkflowTriggerObjects(trigger *WorkflowTrigger) ([]*WorkflowTriggerObject, error) {
+	config, err := c.GetNamespaceConfig(trigger.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if config.ArtifactRepository.S3 == nil {
+		return nil, nil
+	}
+
+	s3Client, err := c.GetS3Client(trigger.Namespace, config.ArtifactRepository.S3)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := make([]*WorkflowTriggerObject, 0)
+	doneCh := make(chan struct{})
+	defer close(doneCh)
+	for objInfo := range s3Client.ListObjects(config.ArtifactRepository.S3.Bucket, trigger.Prefix, true, doneCh) {
+		if objInfo.Err != nil {
+			return nil, objInfo.Err
+		}
+
+		object := &WorkflowTriggerObject{
+			Bucket:       config.ArtifactRepository.S3.Bucket,
+			Key:          objInfo.Key,
+			Size:         objInfo.Size,
+			ETag:         objInfo.ETag,
+			LastModified: objInfo.LastModified,
+		}
+		if trigger.matchesObject(object) {
+			objects = append(objects, object)
+		}
+	}
+
+	return objects, nil
+}
+
+// claimWorkflowTriggerObject records that the object fires the trigger.
+// It returns false if the object already fired it, so each object starts a single workflow.
+func (c *Client) claimWorkflowTriggerObject(triggerID uint64, key string) (id uint64, claimed bool, err error) {
+	rows, err := sb.Insert("workflow_trigger_objects").
+		SetMap(sq.Eq{
+			"trigger_id": triggerID,
+			"key":        key,
+		}).
+		Suffix("ON CONFLICT (trigger_id, key) DO NOTHING RETURNING id").
+		RunWith(c.DB).
+		Query()
+	if err != nil {
+		return 0, false, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return 0, false, rows.Err()
+	}
+	if err := rows.Scan(&id); err != nil {
+		return 0, false, err
+	}
+
+	return id, true, nil
+}
+
+// releaseWorkflowTriggerObject deletes the claim of an object whose workflow failed to start, so the next poll retries it
+func (c *Client) releaseWorkflowTriggerObject(id uint64) error {
+	_, err := sb.Delete("workflow_trigger_objects").
+		Where(sq.Eq{
+			"id":            id,
+			"workflow_name": nil,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// startArtifactWorkflowTrigger starts the workflow for the object claimed with id
+func (c *Client) startArtifactWorkflowTrigger(trigger *WorkflowTrigger, object *WorkflowTriggerObject, id uint64) error {
+	parameters, err := trigger.objectWorkflowParameters(object)
+	if err != nil {
+		return err
+	}
+
+	workflow, err := c.runWorkflowTrigger(trigger, parameters)
+	if err != nil {
+		return err
+	}
+
+	_, err = sb.Update("workflow_trigger_objects").
+		Set("workflow_name", workflow.Name).
+		Where(sq.Eq{"id": id}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		// The workflow started, so the object keeps its claim
+		log.WithFields(log.Fields{
+			"Namespace": trigger.Namespace,
+			"Trigger":   trigger.UID,
+			"Key":       object.Key,
+			"Workflow":  workflow.Name,
+			"Error":     err.Error(),
+		}).Error("Unable to record workflow of object.")
+	}
+
+	return nil
+}
+
+// fireArtifactWorkflowTriggerObjects starts a workflow for each of the objects that did not fire the artifact trigger yet.
+// Each object is claimed before its workflow is started. If a workflow fails to start, e.g. because the parameters
+// of the object are not valid, its claim is deleted so the object can be retried, and failed is true.
+func (c *Client) fireArtifactWorkflowTriggerObjects(trigger *WorkflowTrigger, objects []*WorkflowTriggerObject) (failed bool, err error) {
+	for _, object := range objects {
+		id, claimed, err := c.claimWorkflowTriggerObject(trigger.ID, object.Key)
+		if err != nil {
+			return failed, err
+		}
+		if !claimed {
+			continue
+		}
+
+		err = c.startArtifactWorkflowTrigger(trigger, object, id)
+		if err == nil {
+			continue
+		}
+
+		failed = true
+		log.WithFields(log.Fields{
+			"Namespace": trigger.Namespace,
+			"Trigger":   trigger.UID,
+			"Key":       object.Key,
+			"Error":     err.Error(),
+		}).Error("Unable to start workflow for object.")
+
+		if err := c.releaseWorkflowTriggerObject(id); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": trigger.Namespace,
+				"Trigger":   trigger.UID,
+				"Key":       object.Key,
+				"Error":     err.Error(),
+			}).Error("Unable to release object.")
+		}
+	}
+
+	return failed, nil
+}
+
+// fireArtifactWorkflowTrigger starts a workflow for each new object of the artifact trigger.
+// Only objects last modified since the previous poll are listed. If a workflow fails to start,
+// the poll time is kept, so the next poll retries the object, see fireArtifactWorkflowTriggerObjects.
+func (c *Client) fireArtifactWorkflowTrigger(trigger *WorkflowTrigger) error {
+	polledAt := time.Now().UTC()
+
+	objects, err := c.listWorkflowTriggerObjects(trigger)
+	if err != nil {
+		return err
+	}
+
+	failed, err := c.fireArtifactWorkflowTriggerObjects(trigger, objects)
+	if err != nil || failed {
+		return err
+	}
+
+	_, err = sb.Update("workflow_triggers").
+		Set("polled_at", polledAt).
+		Where(sq.Eq{"id": trigger.ID}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// FireArtifactWorkflowTriggers starts a workflow for each new object of the artifact triggers of all namespaces
+func (c *Client) FireArtifactWorkflowTriggers() error {
+	query := workflowTriggerSelectBuilder().
+		Where(sq.Eq{
+			"t.type": WorkflowTriggerArtifact,
+		}).
+		OrderBy("t.id")
+
+	triggers := make([]*WorkflowTrigger, 0)
+	if err := c.DB.Selectx(&triggers, query); err != nil {
+		return err
+	}
+
+	for _, trigger := range triggers {
+		if err := c.fireArtifactWorkflowTrigger(trigger); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": trigger.Namespace,
+				"Trigger":   trigger.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to poll artifact trigger.")
+		}
+	}
+
+	return nil
+}
+
+// RunArtifactWorkflowTriggerController polls the artifact repositories for the artifact triggers every interval until stopCh is closed
+func (c *Client) RunArtifactWorkflowTriggerController(interval time.Duration, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if err := c.FireArtifactWorkflowTriggers(); err != nil {
+				log.WithFields(log.Fields{
+					"Error": err.Error(),
+				}).Error("Unable to fire artifact workflow triggers.")
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+// TestClient_claimWorkflowTriggerObject makes sure an object is claimed once, and can be claimed again once released
+func TestClient_claimWorkflowTriggerObject(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	workflowTemplate := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	workflowTemplate, err := c.CreateWorkflowTemplate("onepanel", workflowTemplate)
+	assert.Nil(t, err)
+
+	trigger, err := c.CreateWorkflowTrigger("onepanel", &WorkflowTrigger{
+		Name:                "datasets",
+		Type:                WorkflowTriggerArtifact,
+		WorkflowTemplateUID: workflowTemplate.UID,
+		Prefix:              "/datasets/",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "datasets/", trigger.Prefix)
+
+	id, claimed, err := c.claimWorkflowTriggerObject(trigger.ID, "datasets/train.csv")
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	_, claimed, err = c.claimWorkflowTriggerObject(trigger.ID, "datasets/train.csv")
+	assert.Nil(t, err)
+	assert.False(t, claimed)
+
+	_, claimed, err = c.claimWorkflowTriggerObject(trigger.ID, "datasets/test.csv")
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+
+	assert.Nil(t, c.releaseWorkflowTriggerObject(id))
+
+	_, claimed, err = c.claimWorkflowTriggerObject(trigger.ID, "datasets/train.csv")
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+}
+
+// TestClient_fireArtifactWorkflowTriggerObjects_InvalidParameters makes sure an object whose parameters are not valid
+// for the workflow template does not start a workflow, and is released and logged so it can be retried
+func TestClient_fireArtifactWorkflowTriggerObjects_InvalidParameters(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	workflowTemplate, err := c.CreateWorkflowTemplate("onepanel", &WorkflowTemplate{
+		Name: "test",
+		Manifest: `arguments:
+  parameters:
+  - name: epochs
+    value: "1"
+    type: input.number
+entrypoint: main
+templates:
+- name: main
+  container:
+    image: alpine`,
+	})
+	assert.Nil(t, err)
+
+	trigger, err := c.CreateWorkflowTrigger("onepanel", &WorkflowTrigger{
+		Name:                "datasets",
+		Type:                WorkflowTriggerArtifact,
+		WorkflowTemplateUID: workflowTemplate.UID,
+		Parameters: WorkflowTriggerParameters{
+			{Name: "epochs", Path: "$.key"},
+		},
+	})
+	assert.Nil(t, err)
+
+	hook := test.NewGlobal()
+	defer hook.Reset()
+
+	failed, err := c.fireArtifactWorkflowTriggerObjects(trigger, []*WorkflowTriggerObject{
+		{Key: "datasets/train.csv"},
+	})
+	assert.Nil(t, err)
+	assert.True(t, failed)
+	assert.Equal(t, "Unable to start workflow for object.", hook.LastEntry().Message)
+
+	workflows, err := c.ArgoprojV1alpha1().Workflows("onepanel").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, workflows.Items, 0)
+
+	_, claimed, err := c.claimWorkflowTriggerObject(trigger.ID, "datasets/train.csv")
+	assert.Nil(t, err)
+	assert.True(t, claimed)
+}
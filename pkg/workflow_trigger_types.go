@@ -0,0 +1,369 @@
+package v1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/onepanelio/core/pkg/util/sql"
+	"google.golang.org/grpc/codes"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// WorkflowTriggerType is what starts the workflows of a WorkflowTrigger
+type WorkflowTriggerType string
+
+const (
+	// WorkflowTriggerWebhook starts a workflow for each request to the webhook of the trigger
+	WorkflowTriggerWebhook WorkflowTriggerType = "webhook"
+	// WorkflowTriggerArtifact starts a workflow for each new object under the prefix of the trigger
+	// in the artifact repository of the namespace
+	WorkflowTriggerArtifact WorkflowTriggerType = "artifact"
+)
+
+// WorkflowTriggerSignatureHeader is the header with the signature of a webhook request, see WorkflowTriggerSignature
+const WorkflowTriggerSignatureHeader = "X-Onepanel-Signature"
+
+// WorkflowTriggerTimestampHeader is the header with the time a webhook request was signed at, in unix seconds
+const WorkflowTriggerTimestampHeader = "X-Onepanel-Timestamp"
+
+// workflowTriggerSignatureTolerance is how far from the current time a webhook request may have been signed.
+// Older requests are rejected, so a captured request can not be replayed later.
+const workflowTriggerSignatureTolerance = 5 * time.Minute
+
+// workflowTriggerPollOverlap is how long before the last poll of an artifact trigger an object may have been
+// last modified and still fire it, for objects that were not listed yet when the trigger was last polled
+const workflowTriggerPollOverlap = 10 * time.Minute
+
+// workflowTriggerObjectKeyParameter is the parameter set to the key of the object that fired an artifact trigger,
+// unless the trigger sets a parameter with the same name
+const workflowTriggerObjectKeyParameter = "object-key"
+
+// workflowTriggerLabelKey labels the workflow executions started by a trigger with its uid
+const workflowTriggerLabelKey = "trigger"
+
+// WorkflowTriggerObject is the payload of the event of an artifact trigger, for a new object in the artifact repository
+type WorkflowTriggerObject struct {
+	Bucket       string    `json:"bucket"`
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	ETag         string    `json:"etag"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+// WorkflowTriggerParameter sets a parameter of the workflows started by a trigger.
+// The value is the one at Path, a JSONPath like $.dataset.path, in the payload of the event.
+// Value is used if there is no Path or the payload has no value at Path.
+type WorkflowTriggerParameter struct {
+	Name  string  `json:"name"`
+	Path  string  `json:"path"`
+	Value *string `json:"value"`
+}
+
+// WorkflowTriggerParameters are the parameters set by a WorkflowTrigger
+type WorkflowTriggerParameters []*WorkflowTriggerParameter
+
+// Value returns p as json.
+// This is to support WorkflowTriggerParameters working with JSONB column types in sql
+func (p WorkflowTriggerParameters) Value() (driver.Value, error) {
+	if p == nil {
+		return json.Marshal(make([]*WorkflowTriggerParameter, 0))
+	}
+
+	return json.Marshal(p)
+}
+
+// Scan stores the src in p.  No validation is done.
+// This is to support WorkflowTriggerParameters working with JSONB column types in sql
+func (p *WorkflowTriggerParameters) Scan(src interface{}) error {
+	var source []byte
+	switch t := src.(type) {
+	case string:
+		source = []byte(t)
+	case []byte:
+		if len(t) == 0 {
+			source = []byte("[]")
+		} else {
+			source = t
+		}
+	case nil:
+		*p = make([]*WorkflowTriggerParameter, 0)
+		return nil
+	default:
+		return errors.New("incompatible type for WorkflowTriggerParameters")
+	}
+
+	return json.Unmarshal(source, p)
+}
+
+// WorkflowTrigger starts workflows from a version of a WorkflowTemplate when an event happens
+type WorkflowTrigger struct {
+	ID                        uint64
+	UID                       string
+	Name                      string
+	Namespace                 string
+	Type                      WorkflowTriggerType
+	WorkflowTemplateVersionID uint64                    `db:"workflow_template_version_id"`
+	WorkflowTemplateUID       string                    `db:"workflow_template_uid"`
+	WorkflowTemplateVersion   int64                     `db:"workflow_template_version"`
+	Parameters                WorkflowTriggerParameters `db:"parameters"`
+	// Prefix is the prefix of the keys of the objects of an artifact trigger
+	Prefix string
+	// Pattern is a glob, as in path.Match, that the keys of the objects of an artifact trigger must match, e.g. datasets/*/*.csv
+	Pattern string
+	// PolledAt is when the artifact repository was last polled for the objects of an artifact trigger
+	PolledAt        *time.Time `db:"polled_at"`
+	LastTriggeredAt *time.Time `db:"last_triggered_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+}
+
+// getWorkflowTriggerColumns returns all of the columns for workflow_triggers modified by alias, destination.
+// see formatColumnSelect
+func getWorkflowTriggerColumns(aliasAndDestination ...string) []string {
+	columns := []string{
+		"id",
+		"uid",
+		"name",
+		"namespace",
+		"type",
+		"workflow_template_version_id",
+		"parameters",
+		"prefix",
+		"pattern",
+		"polled_at",
+		"last_triggered_at",
+		"created_at",
+	}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// parseWorkflowTriggerPath parses a JSONPath, with or without the surrounding braces
+func parseWorkflowTriggerPath(path string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+
+	parser := jsonpath.New("parameter")
+	parser.AllowMissingKeys(true)
+	if err := parser.Parse(path); err != nil {
+		return nil, err
+	}
+
+	return parser, nil
+}
+
+// validate checks that the trigger has a name, a known type and parameters with valid paths
+func (t *WorkflowTrigger) validate() error {
+	if t.Name == "" {
+		return errors.New("name is required")
+	}
+
+	switch t.Type {
+	case WorkflowTriggerWebhook:
+	case WorkflowTriggerArtifact:
+		if t.Pattern != "" {
+			if _, err := path.Match(t.Pattern, ""); err != nil {
+				return fmt.Errorf("invalid pattern '%v': %v", t.Pattern, err)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown trigger type '%v'", t.Type)
+	}
+
+	for _, parameter := range t.Parameters {
+		if parameter.Name == "" {
+			return errors.New("parameter name is required")
+		}
+		if parameter.Path == "" {
+			continue
+		}
+		if _, err := parseWorkflowTriggerPath(parameter.Path); err != nil {
+			return fmt.Errorf("invalid path '%v' of parameter '%v': %v", parameter.Path, parameter.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// objectsModifiedSince returns the time the objects of the artifact repository must be last modified at, or after,
+// to fire the artifact trigger. Older objects were listed by a previous poll, or predate the trigger.
+func (t *WorkflowTrigger) objectsModifiedSince() time.Time {
+	if t.PolledAt == nil {
+		return t.CreatedAt
+	}
+
+	since := t.PolledAt.Add(-workflowTriggerPollOverlap)
+	if since.Before(t.CreatedAt) {
+		return t.CreatedAt
+	}
+
+	return since
+}
+
+// matchesObject returns true if the object of the artifact repository fires the artifact trigger.
+// Directories and objects last modified before objectsModifiedSince never fire it.
+func (t *WorkflowTrigger) matchesObject(object *WorkflowTriggerObject) bool {
+	if strings.HasSuffix(object.Key, "/") || !strings.HasPrefix(object.Key, t.Prefix) {
+		return false
+	}
+
+	if object.LastModified.Before(t.objectsModifiedSince()) {
+		return false
+	}
+
+	if t.Pattern == "" {
+		return true
+	}
+
+	matched, err := path.Match(t.Pattern, object.Key)
+
+	return err == nil && matched
+}
+
+// workflowParameters returns the parameters of the workflow started for the event with the json payload
+func (t *WorkflowTrigger) workflowParameters(payload []byte) ([]Parameter, error) {
+	var data interface{}
+	if len(payload) != 0 {
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return nil, fmt.Errorf("payload is not valid json: %v", err)
+		}
+	}
+
+	parameters := make([]Parameter, 0)
+	for _, triggerParameter := range t.Parameters {
+		value := triggerParameter.Value
+		if triggerParameter.Path != "" && data != nil {
+			pathValue, err := workflowTriggerPathValue(triggerParameter.Path, data)
+			if err != nil {
+				return nil, err
+			}
+			if pathValue != nil {
+				value = pathValue
+			}
+		}
+
+		if value == nil {
+			continue
+		}
+
+		parameters = append(parameters, Parameter{
+			Name:  triggerParameter.Name,
+			Value: value,
+		})
+	}
+
+	return parameters, nil
+}
+
+// objectWorkflowParameters returns the parameters of the workflow started for the object of an artifact trigger.
+// The key of the object is passed as workflowTriggerObjectKeyParameter, unless the trigger sets that parameter.
+func (t *WorkflowTrigger) objectWorkflowParameters(object *WorkflowTriggerObject) ([]Parameter, error) {
+	payload, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, err := t.workflowParameters(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, parameter := range parameters {
+		if parameter.Name == workflowTriggerObjectKeyParameter {
+			return parameters, nil
+		}
+	}
+
+	return append(parameters, Parameter{
+		Name:  workflowTriggerObjectKeyParameter,
+		Value: ptr.String(object.Key),
+	}), nil
+}
+
+// workflowTriggerPathValue returns the value at the path in data, or nil if there is none.
+// Values that are not strings are returned as json.
+func workflowTriggerPathValue(path string, data interface{}) (*string, error) {
+	parser, err := parseWorkflowTriggerPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := parser.FindResults(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 || len(results[0]) == 0 {
+		return nil, nil
+	}
+
+	result := results[0][0].Interface()
+	if result == nil {
+		return nil, nil
+	}
+	if value, ok := result.(string); ok {
+		return ptr.String(value), nil
+	}
+
+	valueBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return ptr.String(string(valueBytes)), nil
+}
+
+// WorkflowTriggerWebhookRequest is a request to the webhook of the trigger namespace/uid
+type WorkflowTriggerWebhookRequest struct {
+	Namespace string
+	UID       string
+	// Timestamp is when the request was signed, in unix seconds
+	Timestamp string
+	Payload   []byte
+	Signature string
+}
+
+// WorkflowTriggerSignature returns the signature of a webhook request, for the WorkflowTriggerSignatureHeader.
+// The signature is "sha256=" followed by the hex encoded HMAC-SHA256, keyed with the HMAC key of the system config,
+// of the timestamp, namespace, uid and payload of the request joined by dots.
+func WorkflowTriggerSignature(key []byte, namespace, uid, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(timestamp + "." + namespace + "." + uid + "."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns an Unauthenticated error unless the request is signed with the key
+// and was signed within workflowTriggerSignatureTolerance of now
+func (r *WorkflowTriggerWebhookRequest) Verify(key []byte, now time.Time) error {
+	if len(key) == 0 {
+		return util.NewUserError(codes.Unauthenticated, "Invalid signature.")
+	}
+
+	signature := WorkflowTriggerSignature(key, r.Namespace, r.UID, r.Timestamp, r.Payload)
+	if !hmac.Equal([]byte(signature), []byte(r.Signature)) {
+		return util.NewUserError(codes.Unauthenticated, "Invalid signature.")
+	}
+
+	seconds, err := strconv.ParseInt(r.Timestamp, 10, 64)
+	if err != nil {
+		return util.NewUserError(codes.Unauthenticated, "Invalid timestamp.")
+	}
+
+	signedAt := time.Unix(seconds, 0)
+	if signedAt.Before(now.Add(-workflowTriggerSignatureTolerance)) || signedAt.After(now.Add(workflowTriggerSignatureTolerance)) {
+		return util.NewUserError(codes.Unauthenticated, "Timestamp is too old or in the future.")
+	}
+
+	return nil
+}
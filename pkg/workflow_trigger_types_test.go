@@ -0,0 +1,141 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowTrigger_workflowParameters(t *testing.T) {
+	trigger := &WorkflowTrigger{
+		Parameters: WorkflowTriggerParameters{
+			{Name: "dataset", Path: "$.dataset.path"},
+			{Name: "epochs", Path: "{.config.epochs}"},
+			{Name: "tags", Path: "$.tags"},
+			{Name: "model", Path: "$.model", Value: ptr.String("resnet")},
+			{Name: "optional", Path: "$.optional"},
+		},
+	}
+
+	parameters, err := trigger.workflowParameters([]byte(`{"dataset":{"path":"s3://bucket/data"},"config":{"epochs":10},"tags":["a","b"]}`))
+	assert.Nil(t, err)
+	assert.Len(t, parameters, 4)
+	assert.Equal(t, "s3://bucket/data", *parameters[0].Value)
+	assert.Equal(t, "10", *parameters[1].Value)
+	assert.Equal(t, `["a","b"]`, *parameters[2].Value)
+	assert.Equal(t, "resnet", *parameters[3].Value)
+
+	_, err = trigger.workflowParameters([]byte(`not json`))
+	assert.NotNil(t, err)
+}
+
+// TestWorkflowTriggerWebhookRequest_Verify makes sure only recent requests signed for the trigger are accepted
+func TestWorkflowTriggerWebhookRequest_Verify(t *testing.T) {
+	key := []byte("key")
+	now := time.Unix(1638864000, 0)
+	request := &WorkflowTriggerWebhookRequest{
+		Namespace: "onepanel",
+		UID:       "dataset-landed",
+		Timestamp: "1638864000",
+		Payload:   []byte(`{"dataset":{"path":"s3://bucket/data"}}`),
+	}
+	request.Signature = WorkflowTriggerSignature(key, request.Namespace, request.UID, request.Timestamp, request.Payload)
+
+	assert.Nil(t, request.Verify(key, now))
+	assert.Nil(t, request.Verify(key, now.Add(workflowTriggerSignatureTolerance)))
+	assert.NotNil(t, request.Verify(key, now.Add(workflowTriggerSignatureTolerance+time.Second)))
+	assert.NotNil(t, request.Verify(key, now.Add(-workflowTriggerSignatureTolerance-time.Second)))
+	assert.NotNil(t, request.Verify([]byte("other"), now))
+	assert.NotNil(t, request.Verify(nil, now))
+
+	other := *request
+	other.UID = "other-trigger"
+	assert.NotNil(t, other.Verify(key, now))
+
+	other = *request
+	other.Timestamp = "1638864060"
+	assert.NotNil(t, other.Verify(key, now))
+
+	other = *request
+	other.Payload = []byte(`{}`)
+	assert.NotNil(t, other.Verify(key, now))
+}
+
+func TestWorkflowTrigger_validate(t *testing.T) {
+	trigger := &WorkflowTrigger{
+		Name:       "dataset-landed",
+		Type:       WorkflowTriggerWebhook,
+		Parameters: WorkflowTriggerParameters{{Name: "dataset", Path: "$.dataset.path"}},
+	}
+	assert.Nil(t, trigger.validate())
+
+	trigger.Parameters[0].Path = "$.dataset["
+	assert.NotNil(t, trigger.validate())
+
+	trigger.Parameters[0].Path = ""
+	trigger.Type = WorkflowTriggerArtifact
+	trigger.Pattern = "datasets/[a"
+	assert.NotNil(t, trigger.validate())
+
+	trigger.Pattern = "datasets/*.csv"
+	assert.Nil(t, trigger.validate())
+
+	trigger.Type = "unknown"
+	assert.NotNil(t, trigger.validate())
+}
+
+func TestWorkflowTrigger_matchesObject(t *testing.T) {
+	createdAt := time.Date(2021, 12, 9, 0, 0, 0, 0, time.UTC)
+	trigger := &WorkflowTrigger{
+		Type:      WorkflowTriggerArtifact,
+		Prefix:    "datasets/",
+		Pattern:   "datasets/*/*.csv",
+		CreatedAt: createdAt,
+	}
+	object := func(key string, lastModified time.Time) *WorkflowTriggerObject {
+		return &WorkflowTriggerObject{Key: key, LastModified: lastModified}
+	}
+	after := createdAt.Add(time.Minute)
+
+	assert.True(t, trigger.matchesObject(object("datasets/cats/train.csv", after)))
+	assert.False(t, trigger.matchesObject(object("datasets/cats/train.csv", createdAt.Add(-time.Minute))))
+	assert.False(t, trigger.matchesObject(object("datasets/cats/train.json", after)))
+	assert.False(t, trigger.matchesObject(object("datasets/cats/", after)))
+	assert.False(t, trigger.matchesObject(object("models/cats/train.csv", after)))
+
+	trigger.Pattern = ""
+	assert.True(t, trigger.matchesObject(object("datasets/cats/train.json", after)))
+
+	polledAt := createdAt.Add(time.Hour)
+	trigger.PolledAt = &polledAt
+	assert.True(t, trigger.matchesObject(object("datasets/cats/train.csv", polledAt.Add(-workflowTriggerPollOverlap))))
+	assert.False(t, trigger.matchesObject(object("datasets/cats/train.csv", polledAt.Add(-workflowTriggerPollOverlap-time.Second))))
+}
+
+// TestWorkflowTrigger_objectWorkflowParameters makes sure the key of the object is passed, unless the trigger sets it
+func TestWorkflowTrigger_objectWorkflowParameters(t *testing.T) {
+	trigger := &WorkflowTrigger{
+		Type: WorkflowTriggerArtifact,
+		Parameters: WorkflowTriggerParameters{
+			{Name: "size", Path: "$.size"},
+		},
+	}
+	object := &WorkflowTriggerObject{Key: "datasets/train.csv", Size: 10}
+
+	parameters, err := trigger.objectWorkflowParameters(object)
+	assert.Nil(t, err)
+	assert.Len(t, parameters, 2)
+	assert.Equal(t, "10", *parameters[0].Value)
+	assert.Equal(t, workflowTriggerObjectKeyParameter, parameters[1].Name)
+	assert.Equal(t, "datasets/train.csv", *parameters[1].Value)
+
+	trigger.Parameters = WorkflowTriggerParameters{
+		{Name: workflowTriggerObjectKeyParameter, Value: ptr.String("fixed")},
+	}
+	parameters, err = trigger.objectWorkflowParameters(object)
+	assert.Nil(t, err)
+	assert.Len(t, parameters, 1)
+	assert.Equal(t, "fixed", *parameters[0].Value)
+}
@@ -27,8 +27,9 @@ import (
 
 // WorkspaceFilter represents the available ways we can filter Workspaces
 type WorkspaceFilter struct {
-	Labels []*Label
-	Phase  string // empty string means none
+	Labels      []*Label
+	Phase       string // empty string means none
+	TemplateUID string // empty string means any
 }
 
 // GetLabels gets the labels of the filter
@@ -56,6 +57,12 @@ func applyWorkspaceFilter(sb sq.SelectBuilder, request *request.Request) (sq.Sel
 		})
 	}
 
+	if filter.TemplateUID != "" {
+		sb = sb.Where(sq.Eq{
+			"wt.uid": filter.TemplateUID,
+		})
+	}
+
 	// template, name are reserved labels.
 	// we query the columns on the appropriate tables instead
 	finalLabels := make([]*Label, 0)
@@ -108,10 +115,14 @@ func workspaceStatusToFieldMap(status *WorkspaceStatus) sq.Eq {
 	switch status.Phase {
 	case WorkspaceLaunching:
 		fieldMap["paused_at"] = pq.NullTime{}
+		fieldMap["paused_reason"] = nil
 		fieldMap["started_at"] = time.Now().UTC()
 	case WorkspacePausing:
 		fieldMap["started_at"] = pq.NullTime{}
 		fieldMap["paused_at"] = time.Now().UTC()
+		if status.PausedReason != nil {
+			fieldMap["paused_reason"] = status.PausedReason
+		}
 	case WorkspaceUpdating:
 		fieldMap["paused_at"] = pq.NullTime{}
 		fieldMap["updated_at"] = time.Now().UTC()
@@ -276,6 +287,8 @@ func (c *Client) createWorkspace(namespace string, parameters []byte, workspace
 			"workspace_template_version": workspace.WorkspaceTemplate.Version,
 			"labels":                     workspace.Labels,
 			"capture_node":               workspace.CaptureNode,
+			"idle_timeout_minutes":       workspace.IdleTimeoutMinutes,
+			"owner":                      workspace.Owner,
 		}).
 		Suffix("RETURNING id, created_at").
 		RunWith(c.DB).
@@ -507,6 +520,41 @@ func (c *Client) CreateWorkspace(namespace string, workspace *Workspace) (*Works
 		return nil, err
 	}
 
+	workspaceTemplate, err := c.GetWorkspaceTemplate(namespace, workspace.WorkspaceTemplate.UID, workspace.WorkspaceTemplate.Version)
+	if err != nil || workspaceTemplate == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace template not found.")
+	}
+
+	if err := c.validateWorkspaceSecrets(namespace, workspaceTemplate.Manifest, workspace.Parameters); err != nil {
+		return nil, err
+	}
+
+	if workspace.IdleTimeoutMinutes != nil && *workspace.IdleTimeoutMinutes < 0 {
+		return nil, util.NewUserError(codes.InvalidArgument, "Idle timeout must not be negative.")
+	}
+
+	if workspace.SnapshotUID != "" && workspace.SourceWorkspaceUID != "" {
+		return nil, util.NewUserError(codes.InvalidArgument, "A workspace can not be created from both a snapshot and another workspace.")
+	}
+
+	if workspace.Owner == nil {
+		username, err := c.Username()
+		if err != nil {
+			return nil, err
+		}
+		if username != "" {
+			workspace.Owner = ptr.String(username)
+		}
+	}
+
+	var snapshot *WorkspaceSnapshot
+	if workspace.SnapshotUID != "" {
+		snapshot, err = c.getRestorableWorkspaceSnapshot(namespace, workspace.SnapshotUID, workspaceTemplate.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	parameters, err := json.Marshal(workspace.Parameters)
 	if err != nil {
 		return nil, err
@@ -532,18 +580,75 @@ func (c *Client) CreateWorkspace(namespace string, workspace *Workspace) (*Works
 		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
 	}
 
-	workspaceTemplate, err := c.GetWorkspaceTemplate(namespace, workspace.WorkspaceTemplate.UID, workspace.WorkspaceTemplate.Version)
-	if err != nil || workspaceTemplate == nil {
-		return nil, util.NewUserError(codes.NotFound, "Workspace template not found.")
-	}
 	workspace.WorkspaceTemplate = workspaceTemplate
 
-	workspace, err = c.createWorkspace(namespace, parameters, workspace)
+	// The volumes are created before the workspace, so the workspace uses them instead of creating empty ones
+	if snapshot != nil {
+		if err := c.restoreWorkspaceVolumeClaims(namespace, workspace.UID, snapshot, false); err != nil {
+			c.deleteWorkspaceVolumeClaims(namespace, workspace.UID)
+			return nil, err
+		}
+	}
+	if workspace.SourceWorkspaceUID != "" {
+		if err := c.cloneWorkspaceVolumeClaims(namespace, workspace.SourceWorkspaceUID, workspace.UID); err != nil {
+			c.deleteWorkspaceVolumeClaims(namespace, workspace.UID)
+			return nil, err
+		}
+	}
+
+	createdWorkspace, err := c.createWorkspace(namespace, parameters, workspace)
 	if err != nil {
+		if snapshot != nil || workspace.SourceWorkspaceUID != "" {
+			c.deleteWorkspaceVolumeClaims(namespace, workspace.UID)
+		}
+
 		return nil, err
 	}
 
-	return workspace, nil
+	return createdWorkspace, nil
+}
+
+// CloneWorkspace creates a workspace named name with the template version, parameters and labels of the workspace uid.
+// If copyVolumes is true, the volumes of the new workspace are cloned from the volumes of the workspace uid.
+func (c *Client) CloneWorkspace(namespace, uid, name string, copyVolumes bool) (*Workspace, error) {
+	source, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	parameters := make([]Parameter, 0)
+	for _, p := range source.Parameters {
+		if p.Name == "sys-name" {
+			continue
+		}
+
+		parameters = append(parameters, p)
+	}
+	parameters = append(parameters, Parameter{
+		Name:  "sys-name",
+		Value: ptr.String(name),
+	})
+
+	workspace := &Workspace{
+		Name:       name,
+		Labels:     source.Labels,
+		Parameters: parameters,
+		WorkspaceTemplate: &WorkspaceTemplate{
+			UID:     source.WorkspaceTemplate.UID,
+			Version: int64(source.WorkspaceTemplateVersion),
+		},
+		CaptureNode:        source.CaptureNode,
+		IdleTimeoutMinutes: source.IdleTimeoutMinutes,
+	}
+
+	if copyVolumes {
+		workspace.SourceWorkspaceUID = source.UID
+	}
+
+	return c.CreateWorkspace(namespace, workspace)
 }
 
 // StartWorkspace starts a workspace
@@ -641,7 +746,15 @@ func (c *Client) UpdateWorkspaceStatus(namespace, uid string, status *WorkspaceS
 		return nil
 	}
 
+	oldPhase, err := c.getWorkspacePhase(namespace, uid)
+	if err != nil {
+		return err
+	}
+
+	var errorMessage *string
 	if status.Phase == "Failed" || status.Phase == "Error" {
+		errorMessage = ptr.String(fmt.Sprintf("The workflow of the workspace ended with phase %v.", status.Phase))
+
 		workspace, err := c.GetWorkspace(namespace, uid)
 		if err != nil {
 			return err
@@ -660,23 +773,30 @@ func (c *Client) UpdateWorkspaceStatus(namespace, uid string, status *WorkspaceS
 		}
 	}
 
-	result, err := updateWorkspaceStatusBuilder(namespace, uid, status).
+	var workspaceID uint64
+	var parametersBytes []byte
+	err = updateWorkspaceStatusBuilder(namespace, uid, status).
+		Suffix("RETURNING id, parameters").
 		RunWith(c.DB).
-		Exec()
+		QueryRow().
+		Scan(&workspaceID, &parametersBytes)
 	if err != nil {
-		return err
-	}
+		if err == sql.ErrNoRows {
+			return util.NewUserError(codes.NotFound, "Workspace not found.")
+		}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
 		return err
 	}
 
-	if rowsAffected == 0 {
-		return util.NewUserError(codes.NotFound, "Workspace not found.")
-	}
+	c.recordWorkspaceEvent(&WorkspaceEvent{
+		WorkspaceID:  workspaceID,
+		Action:       WorkspaceEventUpdateStatus,
+		OldPhase:     oldPhase,
+		NewPhase:     status.Phase,
+		ErrorMessage: errorMessage,
+	})
 
-	return
+	return c.recordWorkspacePhaseTransition(workspaceID, status.Phase, parametersBytes)
 }
 
 // ListWorkspacesByTemplateID will return all the workspaces for a given workspace template id that are not terminated.
@@ -712,19 +832,9 @@ func (c *Client) ListWorkspaces(namespace string, request *request.Request) (wor
 		Join("workspace_templates wt ON wt.id = w.workspace_template_id").
 		Where(sq.Eq{"w.namespace": namespace})
 
-	if request.HasSorting() {
-		properties := getWorkspaceColumnsMap(true)
-		for _, order := range request.Sort.Properties {
-			if columnName, ok := properties[order.Property]; ok {
-				nullSort := "NULLS FIRST"
-				if order.Direction == "desc" {
-					nullSort = "NULLS LAST" // default in postgres, but let's be explicit
-				}
-				sb = sb.OrderBy(fmt.Sprintf("w.%v %v %v", columnName, order.Direction, nullSort))
-			}
-		}
-	} else {
-		sb = sb.OrderBy("w.created_at DESC")
+	sb, err = c.applyWorkspaceAccess(sb)
+	if err != nil {
+		return nil, err
 	}
 
 	sb, err = applyWorkspaceFilter(sb, request)
@@ -732,12 +842,21 @@ func (c *Client) ListWorkspaces(namespace string, request *request.Request) (wor
 		return nil, err
 	}
 
-	sb = *request.ApplyPaginationToSelect(&sb)
+	// phase is loaded into the workspace status
+	keysetColumns := request.KeysetColumns("w", getWorkspaceColumnsMap(true), map[string]string{"phase": "status.phase"})
+	paginatedSb, err := request.ApplyKeysetPaginationToSelect(&sb, keysetColumns)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
 
-	if err := c.DB.Selectx(&workspaces, sb); err != nil {
+	if err := c.DB.Selectx(&workspaces, *paginatedSb); err != nil {
 		return nil, err
 	}
 
+	if err := request.SetNextContinue(keysetColumns, workspaces); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
 	for _, workspace := range workspaces {
 		if err = json.Unmarshal(workspace.ParametersBytes, &workspace.Parameters); err != nil {
 			return nil, err
@@ -761,6 +880,11 @@ func (c *Client) CountWorkspaces(namespace string, request *request.Request) (co
 			},
 		})
 
+	query, err = c.applyWorkspaceAccess(query)
+	if err != nil {
+		return 0, err
+	}
+
 	query, err = applyWorkspaceFilter(query, request)
 	if err != nil {
 		return 0, err
@@ -783,6 +907,23 @@ func (c *Client) updateWorkspace(namespace, uid, workspaceAction, resourceAction
 		return util.NewUserError(codes.NotFound, "Workspace not found.")
 	}
 
+	oldPhase := workspace.Status.Phase
+	oldParameters := workspace.Parameters
+	defer func() {
+		event := &WorkspaceEvent{
+			WorkspaceID:      workspace.ID,
+			Action:           workspaceEventActions[status.Phase],
+			OldPhase:         oldPhase,
+			NewPhase:         status.Phase,
+			ParameterChanges: diffWorkspaceParameters(oldParameters, mergeWorkspaceParameters(oldParameters, parameters, true)),
+		}
+		if err != nil {
+			event.ErrorMessage = ptr.String(err.Error())
+		}
+
+		c.recordWorkspaceEvent(event)
+	}()
+
 	config, err := c.GetSystemConfig()
 	if err != nil {
 		return
@@ -808,6 +949,12 @@ func (c *Client) updateWorkspace(namespace, uid, workspaceAction, resourceAction
 		return util.NewUserError(codes.NotFound, "Workspace template not found.")
 	}
 
+	if len(parameters) != 0 {
+		if err = c.validateWorkspaceSecrets(namespace, workspaceTemplate.Manifest, workspace.Parameters); err != nil {
+			return
+		}
+	}
+
 	workflowTemplate, err := c.GetWorkflowTemplate(namespace, workspaceTemplate.WorkflowTemplate.UID, workspaceTemplate.WorkflowTemplate.Version)
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -858,7 +1005,12 @@ func (c *Client) UpdateWorkspace(namespace, uid string, parameters []Parameter)
 
 // PauseWorkspace pauses a workspace
 func (c *Client) PauseWorkspace(namespace, uid string) (err error) {
-	return c.updateWorkspace(namespace, uid, "pause", "delete", &WorkspaceStatus{Phase: WorkspacePausing})
+	return c.pauseWorkspace(namespace, uid, WorkspacePausedByUser)
+}
+
+// pauseWorkspace pauses a workspace and records why it was paused
+func (c *Client) pauseWorkspace(namespace, uid, reason string) (err error) {
+	return c.updateWorkspace(namespace, uid, "pause", "delete", &WorkspaceStatus{Phase: WorkspacePausing, PausedReason: ptr.String(reason)})
 }
 
 // ResumeWorkspace resumes a workspace
@@ -902,6 +1054,11 @@ func (c *Client) GetWorkspaceStatisticsForNamespace(namespace string) (report *W
 			"w.namespace": namespace,
 		})
 
+	query, err = c.applyWorkspaceAccess(query)
+	if err != nil {
+		return nil, err
+	}
+
 	report = &WorkspaceStatisticReport{}
 	err = c.DB.Getx(report, query)
 
@@ -1017,6 +1174,11 @@ func (c *Client) ListWorkspacesField(namespace, field string) (value []string, e
 				"w.phase": WorkspaceTerminated,
 			}}).OrderBy(columnName)
 
+	sb, err = c.applyWorkspaceAccess(sb)
+	if err != nil {
+		return nil, err
+	}
+
 	err = c.DB.Selectx(&value, sb)
 
 	return
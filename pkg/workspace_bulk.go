@@ -0,0 +1,127 @@
+package v1
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util/request"
+	log "github.com/sirupsen/logrus"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+// isAllowedOnAllWorkspaces returns true if the user of the client may run the verb on every workspace of the namespace,
+// like a namespace admin, rather than only on the workspaces they have a role in
+func (c *Client) isAllowedOnAllWorkspaces(namespace, verb string) (bool, error) {
+	review, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: namespace,
+				Verb:      verb,
+				Group:     "onepanel.io",
+				Resource:  "workspaces",
+			},
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return review.Status.Allowed, nil
+}
+
+// listWorkspaceBulkTargets returns the uids of the workspaces that match the filter and that the action applies to.
+// Unless allWorkspaces is true, only the workspaces visible to the user of the client are returned.
+func (c *Client) listWorkspaceBulkTargets(namespace string, filter *WorkspaceFilter, action WorkspaceEventAction, allWorkspaces bool) (uids []string, err error) {
+	if err := validateWorkspaceBulkFilter(filter); err != nil {
+		return nil, err
+	}
+
+	query := sb.Select("w.uid").
+		Columns(getWorkspaceStatusColumns("w", "status")...).
+		From("workspaces w").
+		Join("workspace_templates wt ON wt.id = w.workspace_template_id").
+		Where(sq.Eq{"w.namespace": namespace}).
+		OrderBy("w.created_at")
+
+	if !allWorkspaces {
+		query, err = c.applyWorkspaceAccess(query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	query, err = applyWorkspaceFilter(query, &request.Request{Filter: *filter})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaces := make([]*Workspace, 0)
+	if err := c.DB.Selectx(&workspaces, query); err != nil {
+		return nil, err
+	}
+
+	for _, workspace := range workspaces {
+		if workspaceBulkActionApplies(action, workspace.Status.Phase) {
+			uids = append(uids, workspace.UID)
+		}
+	}
+
+	return
+}
+
+// bulkUpdateWorkspaces runs the update for each workspace that matches the filter and the action applies to.
+// The user of the client needs at least the role in a workspace for it to be updated, unless they may run the verb
+// on all of the workspaces of the namespace, see isAllowedOnAllWorkspaces.
+func (c *Client) bulkUpdateWorkspaces(namespace string, filter *WorkspaceFilter, action WorkspaceEventAction, verb string, role WorkspaceRole, update func(uid string) error) ([]*WorkspaceBulkResult, error) {
+	allWorkspaces, err := c.isAllowedOnAllWorkspaces(namespace, verb)
+	if err != nil {
+		return nil, err
+	}
+
+	uids, err := c.listWorkspaceBulkTargets(namespace, filter, action, allWorkspaces)
+	if err != nil {
+		return nil, err
+	}
+
+	results := runWorkspaceBulkAction(uids, workspaceBulkParallelism, func(uid string) error {
+		if !allWorkspaces {
+			if err := c.AuthorizeWorkspace(namespace, uid, role); err != nil {
+				return err
+			}
+		}
+
+		if err := update(uid); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Action":    action,
+				"Error":     err.Error(),
+			}).Error("Bulk workspace action failed.")
+
+			return err
+		}
+
+		return nil
+	})
+
+	return results, nil
+}
+
+// PauseWorkspaces pauses the workspaces that match the filter and are not paused
+func (c *Client) PauseWorkspaces(namespace string, filter *WorkspaceFilter) ([]*WorkspaceBulkResult, error) {
+	return c.bulkUpdateWorkspaces(namespace, filter, WorkspaceEventPause, "update", WorkspaceRoleEditor, func(uid string) error {
+		return c.PauseWorkspace(namespace, uid)
+	})
+}
+
+// ResumeWorkspaces resumes the paused workspaces that match the filter, with their current parameters
+func (c *Client) ResumeWorkspaces(namespace string, filter *WorkspaceFilter) ([]*WorkspaceBulkResult, error) {
+	return c.bulkUpdateWorkspaces(namespace, filter, WorkspaceEventResume, "update", WorkspaceRoleEditor, func(uid string) error {
+		return c.ResumeWorkspace(namespace, uid, nil)
+	})
+}
+
+// DeleteWorkspaces deletes the workspaces that match the filter
+func (c *Client) DeleteWorkspaces(namespace string, filter *WorkspaceFilter) ([]*WorkspaceBulkResult, error) {
+	return c.bulkUpdateWorkspaces(namespace, filter, WorkspaceEventDelete, "delete", WorkspaceRoleOwner, func(uid string) error {
+		return c.DeleteWorkspace(namespace, uid)
+	})
+}
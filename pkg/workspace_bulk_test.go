@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"testing"
+)
+
+// testBulkWorkspaceClient returns a client for the user, who may update all of the workspaces of the namespace if admin is true
+func testBulkWorkspaceClient(username string, admin bool) *Client {
+	c := DefaultTestClient()
+	c.Token = "token"
+	c.username = ptr.String(username)
+	c.Interface.(*fake.Clientset).PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = admin
+		return true, review, nil
+	})
+
+	return c
+}
+
+// TestClient_bulkUpdateWorkspaces_Admin makes sure a namespace admin updates the workspaces of other users,
+// while other users only update the workspaces they have a role in
+func TestClient_bulkUpdateWorkspaces_Admin(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	workspaceTemplate, err := c.CreateWorkspaceTemplate(namespace, &WorkspaceTemplate{
+		Name:     "test",
+		Manifest: jupyterLabWorkspaceManifest,
+	})
+	assert.Nil(t, err)
+
+	workspace := &Workspace{
+		Name:              "test",
+		WorkspaceTemplate: workspaceTemplate,
+		Owner:             ptr.String("owner"),
+	}
+	workspace.GenerateUID("test")
+	_, err = c.createWorkspace(namespace, []byte("[]"), workspace)
+	assert.Nil(t, err)
+
+	filter := &WorkspaceFilter{TemplateUID: workspaceTemplate.UID}
+	update := func(uid string) error {
+		return nil
+	}
+
+	results, err := testBulkWorkspaceClient("admin", true).bulkUpdateWorkspaces(namespace, filter, WorkspaceEventPause, "update", WorkspaceRoleEditor, update)
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, workspace.UID, results[0].UID)
+	assert.Nil(t, results[0].Error)
+
+	results, err = testBulkWorkspaceClient("member", false).bulkUpdateWorkspaces(namespace, filter, WorkspaceEventPause, "update", WorkspaceRoleEditor, update)
+	assert.Nil(t, err)
+	assert.Len(t, results, 0)
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"sync"
+
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+)
+
+// workspaceBulkParallelism is the maximum number of workspaces a bulk action updates at the same time
+const workspaceBulkParallelism = 10
+
+// WorkspaceBulkResult is the result of a bulk action for one of its workspaces.
+// Error is nil if the action was started for the workspace.
+type WorkspaceBulkResult struct {
+	UID   string
+	Error error
+}
+
+// validateWorkspaceBulkFilter makes sure the filter of a bulk action selects workspaces exactly.
+// An empty filter would select every workspace and the template is matched by uid, not by a part of its name.
+func validateWorkspaceBulkFilter(filter *WorkspaceFilter) error {
+	if filter == nil || (len(filter.Labels) == 0 && filter.Phase == "" && filter.TemplateUID == "") {
+		return util.NewUserError(codes.InvalidArgument, "Labels, a phase or a template uid are required to select workspaces.")
+	}
+
+	for _, label := range filter.Labels {
+		if label.Key == "template" || label.Key == "name" {
+			return util.NewUserError(codes.InvalidArgument, "Workspaces can not be selected by a part of their name or template name. Use a template uid instead.")
+		}
+	}
+
+	return nil
+}
+
+// workspaceBulkActionApplies returns true if the action can be taken on a workspace in the phase.
+// Workspaces the action does not apply to, like paused workspaces for a pause, are left out of bulk actions.
+func workspaceBulkActionApplies(action WorkspaceEventAction, phase WorkspacePhase) bool {
+	switch action {
+	case WorkspaceEventPause:
+		return phase != WorkspacePausing && phase != WorkspacePaused && phase != WorkspaceTerminating && phase != WorkspaceTerminated
+	case WorkspaceEventResume:
+		return phase == WorkspacePaused || phase == WorkspaceFailedToResume
+	case WorkspaceEventDelete:
+		return phase != WorkspaceTerminating && phase != WorkspaceTerminated
+	}
+
+	return false
+}
+
+// runWorkspaceBulkAction runs the action for each uid, with at most parallelism running at the same time.
+// The results are in the order of uids.
+func runWorkspaceBulkAction(uids []string, parallelism int, action func(uid string) error) []*WorkspaceBulkResult {
+	results := make([]*WorkspaceBulkResult, len(uids))
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	wg := sync.WaitGroup{}
+	slots := make(chan struct{}, parallelism)
+	for i, uid := range uids {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(i int, uid string) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			results[i] = &WorkspaceBulkResult{
+				UID:   uid,
+				Error: action(uid),
+			}
+		}(i, uid)
+	}
+	wg.Wait()
+
+	return results
+}
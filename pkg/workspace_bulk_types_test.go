@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_runWorkspaceBulkAction(t *testing.T) {
+	var running, maxRunning int32
+	results := runWorkspaceBulkAction([]string{"a", "b", "c", "d", "e"}, 2, func(uid string) error {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+
+		if uid == "c" {
+			return errors.New("failed")
+		}
+
+		return nil
+	})
+
+	assert.LessOrEqual(t, maxRunning, int32(2))
+	assert.Len(t, results, 5)
+	for i, uid := range []string{"a", "b", "c", "d", "e"} {
+		assert.Equal(t, uid, results[i].UID)
+	}
+	assert.Nil(t, results[0].Error)
+	assert.NotNil(t, results[2].Error)
+}
+
+func Test_workspaceBulkActionApplies(t *testing.T) {
+	assert.True(t, workspaceBulkActionApplies(WorkspaceEventPause, WorkspaceRunning))
+	assert.False(t, workspaceBulkActionApplies(WorkspaceEventPause, WorkspacePaused))
+	assert.True(t, workspaceBulkActionApplies(WorkspaceEventResume, WorkspacePaused))
+	assert.False(t, workspaceBulkActionApplies(WorkspaceEventResume, WorkspaceRunning))
+	assert.True(t, workspaceBulkActionApplies(WorkspaceEventDelete, WorkspacePaused))
+	assert.False(t, workspaceBulkActionApplies(WorkspaceEventDelete, WorkspaceTerminating))
+	assert.False(t, workspaceBulkActionApplies(WorkspaceEventUpdate, WorkspaceRunning))
+}
+
+// Test_validateWorkspaceBulkFilter makes sure bulk actions require a filter that selects workspaces exactly
+func Test_validateWorkspaceBulkFilter(t *testing.T) {
+	assert.NotNil(t, validateWorkspaceBulkFilter(nil))
+	assert.NotNil(t, validateWorkspaceBulkFilter(&WorkspaceFilter{}))
+	assert.NotNil(t, validateWorkspaceBulkFilter(&WorkspaceFilter{Labels: []*Label{{Key: "template", Value: "jupyter"}}}))
+
+	assert.Nil(t, validateWorkspaceBulkFilter(&WorkspaceFilter{Phase: string(WorkspaceRunning)}))
+	assert.Nil(t, validateWorkspaceBulkFilter(&WorkspaceFilter{TemplateUID: "jupyterlab"}))
+	assert.Nil(t, validateWorkspaceBulkFilter(&WorkspaceFilter{Labels: []*Label{{Key: "team", Value: "research"}}}))
+}
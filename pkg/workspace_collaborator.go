@@ -0,0 +1,188 @@
+package v1
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+)
+
+// workspaceRoleRow is the owner of a workspace and the role of a collaborator in it
+type workspaceRoleRow struct {
+	Owner            *string
+	CollaboratorRole *string `db:"collaborator_role"`
+}
+
+// workspaceAccessCondition restricts workspaces, aliased w, to the ones the user has a role in
+func workspaceAccessCondition(username string) sq.Sqlizer {
+	return sq.Or{
+		sq.Eq{"w.owner": nil},
+		sq.Eq{"w.owner": username},
+		sq.Expr("EXISTS (SELECT 1 FROM workspace_collaborators wc WHERE wc.workspace_id = w.id AND wc.username = ?)", username),
+	}
+}
+
+// applyWorkspaceAccess restricts the workspaces, aliased w, selected by sb to the ones the user of the client has a role in.
+// Clients without a token, like the ones of the background controllers, are not restricted.
+func (c *Client) applyWorkspaceAccess(sb sq.SelectBuilder) (sq.SelectBuilder, error) {
+	username, err := c.Username()
+	if err != nil {
+		return sb, err
+	}
+	if username == "" {
+		return sb, nil
+	}
+
+	return sb.Where(workspaceAccessCondition(username)), nil
+}
+
+// GetWorkspaceRole returns the role of the user in the workspace, or an empty role if the workspace is not shared with them
+func (c *Client) GetWorkspaceRole(namespace, uid, username string) (WorkspaceRole, error) {
+	query := sb.Select("w.owner", `wc.role "collaborator_role"`).
+		From("workspaces w").
+		LeftJoin("workspace_collaborators wc ON wc.workspace_id = w.id AND wc.username = ?", username).
+		Where(sq.And{
+			sq.Eq{
+				"w.namespace": namespace,
+				"w.uid":       uid,
+			},
+			sq.NotEq{
+				"w.phase": WorkspaceTerminated,
+			},
+		})
+
+	row := &workspaceRoleRow{}
+	if err := c.DB.Getx(row, query); err != nil {
+		if err == sql.ErrNoRows {
+			return "", util.NewUserError(codes.NotFound, "Workspace not found.")
+		}
+
+		return "", err
+	}
+
+	return workspaceRole(row.Owner, row.CollaboratorRole, username), nil
+}
+
+// AuthorizeWorkspace returns a PermissionDenied error if the user of the client does not have at least the role in the workspace
+func (c *Client) AuthorizeWorkspace(namespace, uid string, role WorkspaceRole) error {
+	username, err := c.Username()
+	if err != nil {
+		return err
+	}
+
+	userRole, err := c.GetWorkspaceRole(namespace, uid, username)
+	if err != nil {
+		return err
+	}
+
+	if !userRole.Allows(role) {
+		return util.NewUserError(codes.PermissionDenied, fmt.Sprintf("Permission denied. The workspace is not shared with you as %v.", role))
+	}
+
+	return nil
+}
+
+// ListWorkspaceCollaborators returns the owner of the workspace and the users it is shared with
+func (c *Client) ListWorkspaceCollaborators(namespace, uid string) (owner *string, collaborators []*WorkspaceCollaborator, err error) {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	if workspace == nil {
+		return nil, nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	query := sb.Select("id", "username", "role", "created_at", "modified_at").
+		From("workspace_collaborators").
+		Where(sq.Eq{
+			"workspace_id": workspace.ID,
+		}).
+		OrderBy("username")
+
+	collaborators = make([]*WorkspaceCollaborator, 0)
+	if err := c.DB.Selectx(&collaborators, query); err != nil {
+		return nil, nil, err
+	}
+
+	return workspace.Owner, collaborators, nil
+}
+
+// AddWorkspaceCollaborator shares the workspace with the user. If it is already shared with them, their role is updated.
+func (c *Client) AddWorkspaceCollaborator(namespace, uid, username string, role WorkspaceRole) (*WorkspaceCollaborator, error) {
+	if username == "" {
+		return nil, util.NewUserError(codes.InvalidArgument, "Username is required.")
+	}
+
+	if _, err := ParseWorkspaceCollaboratorRole(string(role)); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+	if workspace == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	if workspace.Owner != nil && *workspace.Owner == username {
+		return nil, util.NewUserError(codes.InvalidArgument, "The owner of a workspace can not be a collaborator.")
+	}
+
+	collaborator := &WorkspaceCollaborator{
+		Username: username,
+		Role:     role,
+	}
+
+	err = sb.Insert("workspace_collaborators").
+		SetMap(sq.Eq{
+			"workspace_id": workspace.ID,
+			"username":     username,
+			"role":         role,
+		}).
+		Suffix("ON CONFLICT (workspace_id, username) DO UPDATE SET role = EXCLUDED.role, modified_at = ? RETURNING id, created_at, modified_at", time.Now().UTC()).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&collaborator.ID, &collaborator.CreatedAt, &collaborator.ModifiedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return collaborator, nil
+}
+
+// RemoveWorkspaceCollaborator stops sharing the workspace with the user
+func (c *Client) RemoveWorkspaceCollaborator(namespace, uid, username string) error {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return err
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	result, err := sb.Delete("workspace_collaborators").
+		Where(sq.Eq{
+			"workspace_id": workspace.ID,
+			"username":     username,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Workspace collaborator not found.")
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorkspaceRole is the access a user has to a workspace
+type WorkspaceRole string
+
+const (
+	// WorkspaceRoleOwner can do everything with a workspace, including deleting it and sharing it
+	WorkspaceRoleOwner WorkspaceRole = "owner"
+	// WorkspaceRoleEditor can use, update, pause and resume a workspace
+	WorkspaceRoleEditor WorkspaceRole = "editor"
+	// WorkspaceRoleViewer can see and use a workspace
+	WorkspaceRoleViewer WorkspaceRole = "viewer"
+)
+
+// workspaceRoleRanks orders the roles from least to most access
+var workspaceRoleRanks = map[WorkspaceRole]int{
+	WorkspaceRoleViewer: 1,
+	WorkspaceRoleEditor: 2,
+	WorkspaceRoleOwner:  3,
+}
+
+// Allows returns true if the role has at least the access of the required role
+func (r WorkspaceRole) Allows(required WorkspaceRole) bool {
+	rank, ok := workspaceRoleRanks[r]
+
+	return ok && rank >= workspaceRoleRanks[required]
+}
+
+// ParseWorkspaceCollaboratorRole returns the role of a collaborator. Collaborators are editors or viewers.
+func ParseWorkspaceCollaboratorRole(value string) (WorkspaceRole, error) {
+	switch WorkspaceRole(value) {
+	case WorkspaceRoleEditor:
+		return WorkspaceRoleEditor, nil
+	case WorkspaceRoleViewer:
+		return WorkspaceRoleViewer, nil
+	}
+
+	return "", fmt.Errorf("role must be %v or %v", WorkspaceRoleEditor, WorkspaceRoleViewer)
+}
+
+// WorkspaceCollaborator is a user a workspace is shared with
+type WorkspaceCollaborator struct {
+	ID         uint64
+	Username   string
+	Role       WorkspaceRole
+	CreatedAt  time.Time  `db:"created_at"`
+	ModifiedAt *time.Time `db:"modified_at"`
+}
+
+// workspaceRole returns the role of username in a workspace with the owner, where the user is a collaborator with
+// collaboratorRole, if any. Workspaces without an owner, created before they could be shared, are owned by everyone.
+func workspaceRole(owner *string, collaboratorRole *string, username string) WorkspaceRole {
+	if owner == nil || *owner == username {
+		return WorkspaceRoleOwner
+	}
+
+	if collaboratorRole != nil {
+		return WorkspaceRole(*collaboratorRole)
+	}
+
+	return ""
+}
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkspaceRole(t *testing.T) {
+	assert.Equal(t, WorkspaceRoleOwner, workspaceRole(nil, nil, "alice"))
+	assert.Equal(t, WorkspaceRoleOwner, workspaceRole(ptr.String("alice"), nil, "alice"))
+	assert.Equal(t, WorkspaceRoleEditor, workspaceRole(ptr.String("alice"), ptr.String("editor"), "bob"))
+	assert.Equal(t, WorkspaceRole(""), workspaceRole(ptr.String("alice"), nil, "bob"))
+}
+
+func TestWorkspaceRole_Allows(t *testing.T) {
+	assert.True(t, WorkspaceRoleOwner.Allows(WorkspaceRoleEditor))
+	assert.True(t, WorkspaceRoleEditor.Allows(WorkspaceRoleEditor))
+	assert.True(t, WorkspaceRoleEditor.Allows(WorkspaceRoleViewer))
+	assert.False(t, WorkspaceRoleViewer.Allows(WorkspaceRoleEditor))
+	assert.False(t, WorkspaceRole("").Allows(WorkspaceRoleViewer))
+}
+
+func TestParseWorkspaceCollaboratorRole(t *testing.T) {
+	role, err := ParseWorkspaceCollaboratorRole("viewer")
+	assert.Nil(t, err)
+	assert.Equal(t, WorkspaceRoleViewer, role)
+
+	_, err = ParseWorkspaceCollaboratorRole("owner")
+	assert.NotNil(t, err)
+}
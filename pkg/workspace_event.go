@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// getWorkspacePhase returns the current phase of the workspace that is not terminated, or a NotFound error
+func (c *Client) getWorkspacePhase(namespace, uid string) (phase WorkspacePhase, err error) {
+	query := sb.Select("phase").
+		From("workspaces").
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			},
+			sq.NotEq{
+				"phase": WorkspaceTerminated,
+			},
+		})
+
+	if err = c.DB.Getx(&phase, query); err != nil {
+		if err == sql.ErrNoRows {
+			return "", util.NewUserError(codes.NotFound, "Workspace not found.")
+		}
+
+		return "", err
+	}
+
+	return phase, nil
+}
+
+// recordWorkspaceEvent adds the event to the history of its workspace, with the user of the client as the actor.
+// Events are recorded after the fact, so failing to record one is logged instead of failing the action.
+func (c *Client) recordWorkspaceEvent(event *WorkspaceEvent) {
+	username, err := c.Username()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"WorkspaceID": event.WorkspaceID,
+			"Error":       err.Error(),
+		}).Error("Unable to get the actor of a workspace event.")
+	}
+	if username != "" {
+		event.Actor = ptr.String(username)
+	}
+
+	_, err = sb.Insert("workspace_events").
+		SetMap(sq.Eq{
+			"workspace_id":      event.WorkspaceID,
+			"action":            event.Action,
+			"actor":             event.Actor,
+			"old_phase":         event.OldPhase,
+			"new_phase":         event.NewPhase,
+			"parameter_changes": event.ParameterChanges,
+			"error_message":     event.ErrorMessage,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"WorkspaceID": event.WorkspaceID,
+			"Action":      event.Action,
+			"Error":       err.Error(),
+		}).Error("Unable to record workspace event.")
+	}
+}
+
+// workspaceEventKeysetColumns are the columns workspace events are ordered by, newest first
+var workspaceEventKeysetColumns = []pagination.KeysetColumn{
+	{Column: "created_at", Field: "created_at", Desc: true},
+	{Column: "id", Field: "id", Desc: true},
+}
+
+// ListWorkspaceEvents returns the history of a workspace, newest first
+func (c *Client) ListWorkspaceEvents(namespace, uid string, paginator *pagination.PaginationRequest) (events []*WorkspaceEvent, err error) {
+	workspaceID, err := c.getWorkspaceID(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	query := sb.Select(getWorkspaceEventColumns()...).
+		From("workspace_events").
+		Where(sq.Eq{
+			"workspace_id": workspaceID,
+		})
+
+	paginatedQuery, err := paginator.ApplyKeysetToSelect(&query, workspaceEventKeysetColumns)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	events = make([]*WorkspaceEvent, 0)
+	if err := c.DB.Selectx(&events, *paginatedQuery); err != nil {
+		return nil, err
+	}
+
+	if err := paginator.SetNextContinue(workspaceEventKeysetColumns, events); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	return events, nil
+}
@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// WorkspaceEventAction is what caused a WorkspaceEvent
+type WorkspaceEventAction string
+
+const (
+	// WorkspaceEventUpdateStatus is a status reported by the workflow of the workspace
+	WorkspaceEventUpdateStatus WorkspaceEventAction = "update-status"
+	// WorkspaceEventUpdate is an update of the parameters of the workspace
+	WorkspaceEventUpdate WorkspaceEventAction = "update"
+	// WorkspaceEventPause is a pause of the workspace, by a user or automatically
+	WorkspaceEventPause WorkspaceEventAction = "pause"
+	// WorkspaceEventResume is a resume of the workspace
+	WorkspaceEventResume WorkspaceEventAction = "resume"
+	// WorkspaceEventDelete is a deletion of the workspace
+	WorkspaceEventDelete WorkspaceEventAction = "delete"
+)
+
+// workspaceEventActions are the actions of updateWorkspace, by the phase they move the workspace to
+var workspaceEventActions = map[WorkspacePhase]WorkspaceEventAction{
+	WorkspaceUpdating:    WorkspaceEventUpdate,
+	WorkspacePausing:     WorkspaceEventPause,
+	WorkspaceLaunching:   WorkspaceEventResume,
+	WorkspaceTerminating: WorkspaceEventDelete,
+}
+
+// diffWorkspaceParameters returns the parameters whose values were added, removed or changed.
+// Only the values are compared, as the parameters of a workspace are stored with the other fields of their template.
+func diffWorkspaceParameters(from, to []Parameter) []*ParameterChange {
+	values := func(parameters []Parameter) []Parameter {
+		result := make([]Parameter, len(parameters))
+		for i, p := range parameters {
+			result[i] = Parameter{
+				Name:  p.Name,
+				Value: p.Value,
+			}
+		}
+
+		return result
+	}
+
+	return diffParameters(values(from), values(to))
+}
+
+// WorkspaceEventParameterChanges are the parameters changed by a WorkspaceEvent
+type WorkspaceEventParameterChanges []*ParameterChange
+
+// Value returns c as json.
+// This is to support WorkspaceEventParameterChanges working with JSONB column types in sql
+func (c WorkspaceEventParameterChanges) Value() (driver.Value, error) {
+	if c == nil {
+		return json.Marshal(make([]*ParameterChange, 0))
+	}
+
+	return json.Marshal(c)
+}
+
+// Scan stores the src in c.  No validation is done.
+// This is to support WorkspaceEventParameterChanges working with JSONB column types in sql
+func (c *WorkspaceEventParameterChanges) Scan(src interface{}) error {
+	var source []byte
+	switch t := src.(type) {
+	case string:
+		source = []byte(t)
+	case []byte:
+		if len(t) == 0 {
+			source = []byte("[]")
+		} else {
+			source = t
+		}
+	case nil:
+		*c = make([]*ParameterChange, 0)
+		return nil
+	default:
+		return errors.New("incompatible type for WorkspaceEventParameterChanges")
+	}
+
+	return json.Unmarshal(source, c)
+}
+
+// WorkspaceEvent is an entry in the history of a workspace.
+// NewPhase is the phase the workspace was moved to, or was to be moved to if the event has an ErrorMessage.
+type WorkspaceEvent struct {
+	ID               uint64
+	WorkspaceID      uint64 `db:"workspace_id"`
+	Action           WorkspaceEventAction
+	Actor            *string
+	OldPhase         WorkspacePhase                 `db:"old_phase"`
+	NewPhase         WorkspacePhase                 `db:"new_phase"`
+	ParameterChanges WorkspaceEventParameterChanges `db:"parameter_changes"`
+	ErrorMessage     *string                        `db:"error_message"`
+	CreatedAt        time.Time                      `db:"created_at"`
+}
+
+// getWorkspaceEventColumns returns all of the columns for workspace_events
+func getWorkspaceEventColumns() []string {
+	return []string{
+		"id",
+		"workspace_id",
+		"action",
+		"actor",
+		"old_phase",
+		"new_phase",
+		"parameter_changes",
+		"error_message",
+		"created_at",
+	}
+}
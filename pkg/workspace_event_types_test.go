@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/extensions"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_diffWorkspaceParameters(t *testing.T) {
+	from := []Parameter{
+		{Name: "sys-node-pool", Value: ptr.String("cpu"), Type: "select.nodepool"},
+		{Name: "storage", Value: ptr.String("20"), Type: "input.number"},
+	}
+	to := mergeWorkspaceParameters(from, []Parameter{{Name: "sys-node-pool", Value: ptr.String("gpu")}}, true)
+
+	changes := diffWorkspaceParameters(from, to)
+
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "sys-node-pool", changes[0].Name)
+	assert.Equal(t, extensions.NodeChangeChanged, changes[0].Type)
+	assert.Equal(t, "cpu", *changes[0].OldValue)
+	assert.Equal(t, "gpu", *changes[0].NewValue)
+}
+
+func TestWorkspaceEventParameterChanges_Scan(t *testing.T) {
+	changes := WorkspaceEventParameterChanges{}
+
+	err := changes.Scan([]byte(`[{"name":"storage","type":"changed","oldValue":"20","newValue":"40"}]`))
+	assert.Nil(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "40", *changes[0].NewValue)
+
+	err = changes.Scan(nil)
+	assert.Nil(t, err)
+	assert.Len(t, changes, 0)
+}
+
+// Test_workspaceEventKeysetColumns makes sure a continue token can be created from the last event of a page
+func Test_workspaceEventKeysetColumns(t *testing.T) {
+	events := []*WorkspaceEvent{
+		{ID: 2, CreatedAt: time.Date(2021, 12, 7, 10, 0, 0, 0, time.UTC)},
+		{ID: 1, CreatedAt: time.Date(2021, 12, 7, 9, 0, 0, 0, time.UTC)},
+	}
+
+	paginator := pagination.NewWithContinue(1, 2, "")
+	assert.Nil(t, paginator.SetNextContinue(workspaceEventKeysetColumns, events))
+	assert.NotEmpty(t, paginator.NextContinue)
+
+	cursor, err := pagination.DecodeCursor(paginator.NextContinue)
+	assert.Nil(t, err)
+	_, err = cursor.Where(workspaceEventKeysetColumns)
+	assert.Nil(t, err)
+}
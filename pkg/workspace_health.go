@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// workspaceHealthTimeout is how long the services of a workspace are probed for, in total
+const workspaceHealthTimeout = 3 * time.Second
+
+// workspaceHealthClient probes the services of workspaces. Redirects are not followed, as any response means the service is up.
+var workspaceHealthClient = &http.Client{
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// probeWorkspaceService returns the health of the service, which is ready if it responds with a status below 500 before ctx is done
+func probeWorkspaceService(ctx context.Context, url string, service *WorkspaceService) *WorkspaceServiceHealth {
+	health := &WorkspaceServiceHealth{
+		Service: service,
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		health.Message = err.Error()
+		return health
+	}
+
+	res, err := workspaceHealthClient.Do(req.WithContext(ctx))
+	if err != nil {
+		health.Message = err.Error()
+		return health
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusInternalServerError {
+		health.Message = fmt.Sprintf("Service responded with status %v.", res.StatusCode)
+		return health
+	}
+
+	health.Ready = true
+
+	return health
+}
+
+// GetWorkspaceServiceHealth probes the services the workspace needs to be ready, in parallel and within workspaceHealthTimeout.
+// The services of a workspace that is not running are not ready, and are not probed.
+func (c *Client) GetWorkspaceServiceHealth(namespace string, workspace *Workspace) ([]*WorkspaceServiceHealth, error) {
+	services, err := workspace.WorkspaceTemplate.GetRoutedServices()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*WorkspaceServiceHealth, len(services))
+	if workspace.Status.Phase != WorkspaceRunning {
+		for i, service := range services {
+			result[i] = &WorkspaceServiceHealth{
+				Service: service,
+				Message: fmt.Sprintf("Workspace is %v.", workspace.Status.Phase),
+			}
+		}
+
+		return result, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), workspaceHealthTimeout)
+	defer cancel()
+
+	wg := sync.WaitGroup{}
+	for i, service := range services {
+		wg.Add(1)
+		go func(i int, service *WorkspaceService) {
+			defer wg.Done()
+
+			url := fmt.Sprintf("http://%v.%v.svc.cluster.local:%v%v", workspace.UID, namespace, service.Port, service.Path)
+			result[i] = probeWorkspaceService(ctx, url, service)
+		}(i, service)
+	}
+	wg.Wait()
+
+	return result, nil
+}
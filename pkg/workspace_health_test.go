@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	networking "istio.io/api/networking/v1alpha3"
+)
+
+func Test_probeWorkspaceService(t *testing.T) {
+	statusCode := http.StatusNotFound
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	service := &WorkspaceService{Name: "sys-filesyncer", Path: "/sys/filesyncer"}
+
+	health := probeWorkspaceService(context.Background(), server.URL+service.Path, service)
+	assert.True(t, health.Ready)
+
+	statusCode = http.StatusBadGateway
+	health = probeWorkspaceService(context.Background(), server.URL+service.Path, service)
+	assert.False(t, health.Ready)
+	assert.NotEmpty(t, health.Message)
+}
+
+func Test_workspaceRoutePort(t *testing.T) {
+	route := func(prefix string, port uint32) *networking.HTTPRoute {
+		return &networking.HTTPRoute{
+			Match: []*networking.HTTPMatchRequest{
+				{Uri: &networking.StringMatch{MatchType: &networking.StringMatch_Prefix{Prefix: prefix}}},
+			},
+			Route: []*networking.HTTPRouteDestination{
+				{Destination: &networking.Destination{Port: &networking.PortSelector{Number: port}}},
+			},
+		}
+	}
+
+	routes := []*networking.HTTPRoute{route("/", 80), route("/sys/filesyncer", 8889)}
+
+	assert.Equal(t, uint32(8889), workspaceRoutePort(routes, "/sys/filesyncer"))
+	assert.Equal(t, uint32(80), workspaceRoutePort(routes, "/lab"))
+	assert.Equal(t, uint32(0), workspaceRoutePort(nil, "/lab"))
+}
+
+// Test_WorkspaceTemplate_GetRoutedServices makes sure every routed path is a service, named after its port
+func Test_WorkspaceTemplate_GetRoutedServices(t *testing.T) {
+	wt := &WorkspaceTemplate{
+		Manifest: `containers:
+- name: jupyterlab
+  image: onepanel/dl:0.17.0
+- name: sys-filesyncer
+  image: onepanel/filesyncer:0.17.0
+  args:
+  - server
+  - -server-prefix=/sys/filesyncer
+ports:
+- name: jupyterlab
+  port: 80
+  protocol: TCP
+  targetPort: 8888
+- name: fs
+  port: 8889
+  protocol: TCP
+  targetPort: 8889
+routes:
+- match:
+  - uri:
+      prefix: /sys/filesyncer
+  route:
+  - destination:
+      port:
+        number: 8889
+- match:
+  - uri:
+      prefix: /
+  route:
+  - destination:
+      port:
+        number: 80
+`,
+	}
+
+	services, err := wt.GetRoutedServices()
+	assert.Nil(t, err)
+	assert.Len(t, services, 2)
+	assert.Equal(t, "sys-filesyncer", services[0].Name)
+	assert.Equal(t, uint32(8889), services[0].Port)
+	assert.Equal(t, "jupyterlab", services[1].Name)
+	assert.Equal(t, "/", services[1].Path)
+	assert.Equal(t, uint32(80), services[1].Port)
+}
+
+// Test_probeWorkspaceService_Deadline makes sure a probe stops when its context is done
+func Test_probeWorkspaceService_Deadline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	health := probeWorkspaceService(ctx, server.URL, &WorkspaceService{Name: "jupyterlab", Path: "/"})
+	assert.False(t, health.Ready)
+	assert.NotEmpty(t, health.Message)
+}
@@ -0,0 +1,276 @@
+package v1
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// workspaceActivityInterval is the minimum time between two records of activity for the same workspace
+const workspaceActivityInterval = time.Minute
+
+// workspaceActivity holds when activity was last recorded for a workspace, keyed by namespace/uid.
+// It keeps traffic to a workspace from writing to the database on every request.
+var workspaceActivity sync.Map
+
+// workspaceIdleTimeout returns how long a workspace may be idle before it is paused, or 0 if it is never paused.
+// The timeout of the workspace takes precedence over the default of its template.
+func workspaceIdleTimeout(workspaceMinutes, templateMinutes *int32) time.Duration {
+	minutes := templateMinutes
+	if workspaceMinutes != nil {
+		minutes = workspaceMinutes
+	}
+
+	if minutes == nil || *minutes <= 0 {
+		return 0
+	}
+
+	return time.Duration(*minutes) * time.Minute
+}
+
+// isWorkspaceIdle returns true if the workspace has had no activity for at least timeout at the time now.
+// A workspace that has had no activity since it started is idle from when it started.
+func isWorkspaceIdle(status *WorkspaceStatus, timeout time.Duration, now time.Time) bool {
+	if timeout == 0 {
+		return false
+	}
+
+	lastActivity := status.StartedAt
+	if status.LastActivityAt != nil && (lastActivity == nil || status.LastActivityAt.After(*lastActivity)) {
+		lastActivity = status.LastActivityAt
+	}
+
+	if lastActivity == nil {
+		return false
+	}
+
+	return now.Sub(*lastActivity) >= timeout
+}
+
+// RecordWorkspaceActivity marks a running workspace as active, so it is not paused for being idle.
+// Activity is written at most once every workspaceActivityInterval for each workspace.
+func (c *Client) RecordWorkspaceActivity(namespace, uid string) error {
+	key := namespace + "/" + uid
+	now := time.Now().UTC()
+
+	if recordedAt, ok := workspaceActivity.Load(key); ok && now.Sub(recordedAt.(time.Time)) < workspaceActivityInterval {
+		return nil
+	}
+
+	_, err := sb.Update("workspaces").
+		Set("last_activity_at", now).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+			"phase":     WorkspaceRunning,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	workspaceActivity.Store(key, now)
+
+	return nil
+}
+
+// UpdateWorkspaceIdleTimeout sets how many minutes the workspace may be idle before it is paused.
+// A nil timeout uses the default of the workspace template and 0 never pauses the workspace.
+func (c *Client) UpdateWorkspaceIdleTimeout(namespace, uid string, minutes *int32) error {
+	if minutes != nil && *minutes < 0 {
+		return util.NewUserError(codes.InvalidArgument, "Idle timeout must not be negative.")
+	}
+
+	result, err := sb.Update("workspaces").
+		SetMap(sq.Eq{
+			"idle_timeout_minutes": minutes,
+			"modified_at":          time.Now().UTC(),
+		}).
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			},
+			sq.NotEq{
+				"phase": WorkspaceTerminated,
+			},
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	return nil
+}
+
+// listRunningWorkspacesWithManifest returns the running workspaces in all namespaces.
+// The manifest of the workspace template version is loaded into WorkspaceTemplate.Manifest.
+func (c *Client) listRunningWorkspacesWithManifest() (workspaces []*Workspace, err error) {
+	query := sb.Select(getWorkspaceColumns("w")...).
+		Columns(getWorkspaceStatusColumns("w", "status")...).
+		Columns(`wtv.manifest "workspace_template.manifest"`).
+		From("workspaces w").
+		Join("workspace_template_versions wtv ON wtv.workspace_template_id = w.workspace_template_id AND wtv.version = w.workspace_template_version").
+		Where(sq.Eq{
+			"w.phase": WorkspaceRunning,
+		})
+
+	err = c.DB.Selectx(&workspaces, query)
+
+	return
+}
+
+// claimIdleWorkspace marks a running workspace as active at the time now, if its activity has not changed since it was listed.
+// Only the caller that claims the workspace pauses it, so replicas of the controller do not pause it more than once.
+func (c *Client) claimIdleWorkspace(workspace *Workspace, now time.Time) (claimed bool, err error) {
+	query := sb.Update("workspaces").
+		Set("last_activity_at", now).
+		Where(sq.And{
+			sq.Eq{
+				"id":    workspace.ID,
+				"phase": WorkspaceRunning,
+			},
+			sq.Expr("last_activity_at IS NOT DISTINCT FROM ?", workspace.Status.LastActivityAt),
+		}).
+		Suffix("RETURNING id")
+
+	id := uint64(0)
+	err = query.RunWith(c.DB).QueryRow().Scan(&id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// releaseIdleWorkspace restores the activity of a workspace claimed at the time now, so it is paused again on the next check.
+func (c *Client) releaseIdleWorkspace(workspace *Workspace, now time.Time) {
+	_, err := sb.Update("workspaces").
+		Set("last_activity_at", workspace.Status.LastActivityAt).
+		Where(sq.Eq{
+			"id":               workspace.ID,
+			"last_activity_at": now,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": workspace.Namespace,
+			"UID":       workspace.UID,
+			"Error":     err.Error(),
+		}).Error("Unable to release idle workspace.")
+	}
+}
+
+// PauseIdleWorkspaces pauses the running workspaces, in all namespaces, that have been idle for longer than their idle timeout.
+// The workspaces that were paused are returned.
+func (c *Client) PauseIdleWorkspaces() (paused []*Workspace, err error) {
+	workspaces, err := c.listRunningWorkspacesWithManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	// Postgres keeps microseconds, so the time of a claim can be matched when it is released
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	specs := make(map[string]*WorkspaceSpec)
+	for _, workspace := range workspaces {
+		specKey := fmt.Sprintf("%v-%v", workspace.WorkspaceTemplateID, workspace.WorkspaceTemplateVersion)
+		spec, ok := specs[specKey]
+		if !ok {
+			spec, err = parseWorkspaceSpec(workspace.WorkspaceTemplate.Manifest)
+			if err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": workspace.Namespace,
+					"UID":       workspace.UID,
+					"Error":     err.Error(),
+				}).Error("Unable to parse workspace template.")
+				continue
+			}
+			if spec == nil {
+				spec = &WorkspaceSpec{}
+			}
+			specs[specKey] = spec
+		}
+
+		timeout := workspaceIdleTimeout(workspace.IdleTimeoutMinutes, spec.IdleTimeoutMinutes)
+		if !isWorkspaceIdle(&workspace.Status, timeout, now) {
+			continue
+		}
+
+		claimed, err := c.claimIdleWorkspace(workspace, now)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": workspace.Namespace,
+				"UID":       workspace.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to claim idle workspace.")
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := c.pauseWorkspace(workspace.Namespace, workspace.UID, WorkspacePausedIdle); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": workspace.Namespace,
+				"UID":       workspace.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to pause idle workspace.")
+			c.releaseIdleWorkspace(workspace, now)
+			continue
+		}
+
+		workspaceActivity.Delete(workspace.Namespace + "/" + workspace.UID)
+		paused = append(paused, workspace)
+	}
+
+	return paused, nil
+}
+
+// RunWorkspaceIdleController pauses idle workspaces every interval until stopCh is closed
+func (c *Client) RunWorkspaceIdleController(interval time.Duration, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			paused, err := c.PauseIdleWorkspaces()
+			if err != nil {
+				log.WithFields(log.Fields{
+					"Error": err.Error(),
+				}).Error("Unable to pause idle workspaces.")
+				continue
+			}
+
+			for _, workspace := range paused {
+				log.WithFields(log.Fields{
+					"Namespace": workspace.Namespace,
+					"UID":       workspace.UID,
+				}).Info("Paused idle workspace.")
+			}
+		}
+	}
+}
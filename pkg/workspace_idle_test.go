@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_workspaceIdleTimeout(t *testing.T) {
+	assert.Equal(t, time.Duration(0), workspaceIdleTimeout(nil, nil))
+	assert.Equal(t, 30*time.Minute, workspaceIdleTimeout(nil, ptr.Int32(30)))
+	assert.Equal(t, 10*time.Minute, workspaceIdleTimeout(ptr.Int32(10), ptr.Int32(30)))
+	assert.Equal(t, time.Duration(0), workspaceIdleTimeout(ptr.Int32(0), ptr.Int32(30)))
+}
+
+func Test_isWorkspaceIdle(t *testing.T) {
+	now := time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)
+	startedAt := now.Add(-2 * time.Hour)
+	lastActivityAt := now.Add(-20 * time.Minute)
+
+	status := &WorkspaceStatus{StartedAt: &startedAt}
+	assert.True(t, isWorkspaceIdle(status, time.Hour, now))
+	assert.False(t, isWorkspaceIdle(status, 0, now))
+
+	status.LastActivityAt = &lastActivityAt
+	assert.False(t, isWorkspaceIdle(status, time.Hour, now))
+	assert.True(t, isWorkspaceIdle(status, 15*time.Minute, now))
+
+	// Activity from before the workspace was resumed does not count
+	resumedAt := now.Add(-5 * time.Minute)
+	status.StartedAt = &resumedAt
+	assert.False(t, isWorkspaceIdle(status, 15*time.Minute, now))
+}
@@ -0,0 +1,259 @@
+package v1
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// getWorkspaceID returns the id of the workspace that is not terminated, or a NotFound error
+func (c *Client) getWorkspaceID(namespace, uid string) (id uint64, err error) {
+	query := sb.Select("id").
+		From("workspaces").
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			},
+			sq.NotEq{
+				"phase": WorkspaceTerminated,
+			},
+		})
+
+	if err = c.DB.Getx(&id, query); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, util.NewUserError(codes.NotFound, "Workspace not found.")
+		}
+
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ListWorkspaceSchedules returns the schedules of a workspace, oldest first
+func (c *Client) ListWorkspaceSchedules(namespace, uid string) (schedules []*WorkspaceSchedule, err error) {
+	workspaceID, err := c.getWorkspaceID(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	query := sb.Select(getWorkspaceScheduleColumns()...).
+		From("workspace_schedules").
+		Where(sq.Eq{
+			"workspace_id": workspaceID,
+		}).
+		OrderBy("created_at", "id")
+
+	schedules = make([]*WorkspaceSchedule, 0)
+	err = c.DB.Selectx(&schedules, query)
+
+	return
+}
+
+// CreateWorkspaceSchedule adds a schedule that resumes or pauses the workspace
+func (c *Client) CreateWorkspaceSchedule(namespace, uid string, schedule *WorkspaceSchedule) (*WorkspaceSchedule, error) {
+	if _, _, err := schedule.parse(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	workspaceID, err := c.getWorkspaceID(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sb.Insert("workspace_schedules").
+		SetMap(sq.Eq{
+			"workspace_id": workspaceID,
+			"action":       schedule.Action,
+			"schedule":     schedule.Schedule,
+			"timezone":     schedule.Timezone,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&schedule.ID, &schedule.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// UpdateWorkspaceSchedule changes the action, schedule and timezone of the schedule with schedule.ID.
+// The next run is computed from the time of the change.
+func (c *Client) UpdateWorkspaceSchedule(namespace, uid string, schedule *WorkspaceSchedule) (*WorkspaceSchedule, error) {
+	if _, _, err := schedule.parse(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	workspaceID, err := c.getWorkspaceID(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = sb.Update("workspace_schedules").
+		SetMap(sq.Eq{
+			"action":      schedule.Action,
+			"schedule":    schedule.Schedule,
+			"timezone":    schedule.Timezone,
+			"modified_at": time.Now().UTC(),
+		}).
+		Where(sq.Eq{
+			"id":           schedule.ID,
+			"workspace_id": workspaceID,
+		}).
+		Suffix("RETURNING last_run_at, created_at, modified_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&schedule.LastRunAt, &schedule.CreatedAt, &schedule.ModifiedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Workspace schedule not found.")
+		}
+
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// DeleteWorkspaceSchedule removes a schedule from the workspace
+func (c *Client) DeleteWorkspaceSchedule(namespace, uid string, id uint64) error {
+	workspaceID, err := c.getWorkspaceID(namespace, uid)
+	if err != nil {
+		return err
+	}
+
+	result, err := sb.Delete("workspace_schedules").
+		Where(sq.Eq{
+			"id":           id,
+			"workspace_id": workspaceID,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Workspace schedule not found.")
+	}
+
+	return nil
+}
+
+// runWorkspaceSchedule resumes or pauses the workspace of the schedule.
+// Nothing is done if the workspace is not paused, for resume, or running, for pause.
+func (c *Client) runWorkspaceSchedule(run *workspaceScheduleRun) error {
+	switch run.Action {
+	case WorkspaceScheduleResume:
+		if run.Phase != WorkspacePaused {
+			return nil
+		}
+
+		return c.ResumeWorkspace(run.Namespace, run.UID, nil)
+	case WorkspaceSchedulePause:
+		if run.Phase != WorkspaceRunning {
+			return nil
+		}
+
+		return c.pauseWorkspace(run.Namespace, run.UID, WorkspacePausedBySchedule)
+	}
+
+	return nil
+}
+
+// RunDueWorkspaceSchedules resumes and pauses workspaces, in all namespaces, whose schedules are due
+func (c *Client) RunDueWorkspaceSchedules() error {
+	query := sb.Select(getWorkspaceScheduleColumns("ws")...).
+		Columns("w.namespace", "w.uid", "w.phase").
+		From("workspace_schedules ws").
+		Join("workspaces w ON w.id = ws.workspace_id").
+		Where(sq.NotEq{
+			"w.phase": WorkspaceTerminated,
+		}).
+		OrderBy("ws.created_at", "ws.id")
+
+	runs := make([]*workspaceScheduleRun, 0)
+	if err := c.DB.Selectx(&runs, query); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	for _, run := range runs {
+		due, err := run.isDue(now)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": run.Namespace,
+				"UID":       run.UID,
+				"Schedule":  run.ID,
+				"Error":     err.Error(),
+			}).Error("Invalid workspace schedule.")
+			continue
+		}
+		if !due {
+			continue
+		}
+
+		// The run is recorded first so a workspace that fails to resume or pause is not retried on every check.
+		// It is only recorded if no other replica recorded it since the schedule was loaded.
+		id := uint64(0)
+		err = sb.Update("workspace_schedules").
+			Set("last_run_at", now).
+			Where(sq.And{
+				sq.Eq{"id": run.ID},
+				sq.Expr("last_run_at IS NOT DISTINCT FROM ?", run.LastRunAt),
+			}).
+			Suffix("RETURNING id").
+			RunWith(c.DB).
+			QueryRow().
+			Scan(&id)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := c.runWorkspaceSchedule(run); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": run.Namespace,
+				"UID":       run.UID,
+				"Schedule":  run.ID,
+				"Action":    run.Action,
+				"Error":     err.Error(),
+			}).Error("Unable to run workspace schedule.")
+		}
+	}
+
+	return nil
+}
+
+// RunWorkspaceScheduleController runs due workspace schedules every interval until stopCh is closed
+func (c *Client) RunWorkspaceScheduleController(interval time.Duration, stopCh <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if err := c.RunDueWorkspaceSchedules(); err != nil {
+				log.WithFields(log.Fields{
+					"Error": err.Error(),
+				}).Error("Unable to run workspace schedules.")
+			}
+		}
+	}
+}
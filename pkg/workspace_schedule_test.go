@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WorkspaceSchedule_NextRunAt(t *testing.T) {
+	schedule := &WorkspaceSchedule{
+		Action:   WorkspaceScheduleResume,
+		Schedule: "0 8 * * 1-5",
+		Timezone: "America/New_York",
+	}
+
+	// Saturday, December 4th 2021, so the next run is on Monday at 8am in New York
+	next, err := schedule.NextRunAt(time.Date(2021, 12, 4, 12, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2021, 12, 6, 13, 0, 0, 0, time.UTC), next)
+}
+
+func Test_WorkspaceSchedule_isDue(t *testing.T) {
+	createdAt := time.Date(2021, 12, 6, 7, 0, 0, 0, time.UTC)
+	schedule := &WorkspaceSchedule{
+		Action:    WorkspaceSchedulePause,
+		Schedule:  "0 20 * * *",
+		CreatedAt: createdAt,
+	}
+
+	due, err := schedule.isDue(time.Date(2021, 12, 6, 19, 59, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.False(t, due)
+
+	due, err = schedule.isDue(time.Date(2021, 12, 6, 20, 0, 30, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.True(t, due)
+
+	lastRunAt := time.Date(2021, 12, 6, 20, 0, 30, 0, time.UTC)
+	schedule.LastRunAt = &lastRunAt
+	due, err = schedule.isDue(time.Date(2021, 12, 6, 20, 1, 30, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.False(t, due)
+}
+
+func Test_WorkspaceSchedule_parse(t *testing.T) {
+	_, _, err := (&WorkspaceSchedule{Action: "stop", Schedule: "0 8 * * *"}).parse()
+	assert.NotNil(t, err)
+
+	_, _, err = (&WorkspaceSchedule{Action: WorkspaceScheduleResume, Schedule: "0 8 * *"}).parse()
+	assert.NotNil(t, err)
+
+	_, _, err = (&WorkspaceSchedule{Action: WorkspaceScheduleResume, Schedule: "0 8 * * *", Timezone: "Mars/Olympus"}).parse()
+	assert.NotNil(t, err)
+
+	schedule := &WorkspaceSchedule{Action: WorkspaceScheduleResume, Schedule: "0 8 * * *"}
+	_, _, err = schedule.parse()
+	assert.Nil(t, err)
+	assert.Equal(t, "UTC", schedule.Timezone)
+}
@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/sql"
+	"github.com/robfig/cron/v3"
+)
+
+// WorkspaceScheduleAction is what a WorkspaceSchedule does to its workspace
+type WorkspaceScheduleAction string
+
+const (
+	// WorkspaceScheduleResume resumes a paused workspace
+	WorkspaceScheduleResume WorkspaceScheduleAction = "resume"
+	// WorkspaceSchedulePause pauses a running workspace
+	WorkspaceSchedulePause WorkspaceScheduleAction = "pause"
+)
+
+// WorkspacePausedBySchedule is the reason of a workspace paused by a WorkspaceSchedule
+const WorkspacePausedBySchedule = "Schedule"
+
+// WorkspaceSchedule resumes or pauses a workspace at the times of a cron schedule, e.g. "0 8 * * 1-5"
+type WorkspaceSchedule struct {
+	ID         uint64
+	Action     WorkspaceScheduleAction
+	Schedule   string
+	Timezone   string
+	LastRunAt  *time.Time `db:"last_run_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+	ModifiedAt *time.Time `db:"modified_at"`
+}
+
+// workspaceScheduleRun is a schedule with the workspace it runs on
+type workspaceScheduleRun struct {
+	WorkspaceSchedule
+	Namespace string
+	UID       string
+	Phase     WorkspacePhase
+}
+
+// getWorkspaceScheduleColumns returns all of the columns for workspace_schedules modified by alias, destination.
+// see formatColumnSelect
+func getWorkspaceScheduleColumns(aliasAndDestination ...string) []string {
+	columns := []string{
+		"id",
+		"action",
+		"schedule",
+		"timezone",
+		"last_run_at",
+		"created_at",
+		"modified_at",
+	}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// parse checks the action, schedule and timezone and returns the parsed schedule and location.
+// An empty timezone is UTC.
+func (s *WorkspaceSchedule) parse() (cron.Schedule, *time.Location, error) {
+	if s.Action != WorkspaceScheduleResume && s.Action != WorkspaceSchedulePause {
+		return nil, nil, fmt.Errorf("action must be %v or %v", WorkspaceScheduleResume, WorkspaceSchedulePause)
+	}
+
+	schedule, err := cron.ParseStandard(s.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule '%v': %v", s.Schedule, err)
+	}
+
+	if s.Timezone == "" {
+		s.Timezone = "UTC"
+	}
+
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid timezone '%v'", s.Timezone)
+	}
+
+	return schedule, location, nil
+}
+
+// NextRunAt returns the first time after after, in UTC, that the schedule runs
+func (s *WorkspaceSchedule) NextRunAt(after time.Time) (time.Time, error) {
+	schedule, location, err := s.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return schedule.Next(after.In(location)).UTC(), nil
+}
+
+// anchor returns the time from which the next run of the schedule is computed:
+// the last run, or when the schedule was last changed if that is later
+func (s *WorkspaceSchedule) anchor() time.Time {
+	anchor := s.CreatedAt
+	if s.ModifiedAt != nil && s.ModifiedAt.After(anchor) {
+		anchor = *s.ModifiedAt
+	}
+	if s.LastRunAt != nil && s.LastRunAt.After(anchor) {
+		anchor = *s.LastRunAt
+	}
+
+	return anchor
+}
+
+// isDue returns true if the schedule has a run at or before now that has not happened yet.
+// Runs missed while the schedule was not checked are collapsed into one.
+func (s *WorkspaceSchedule) isDue(now time.Time) (bool, error) {
+	next, err := s.NextRunAt(s.anchor())
+	if err != nil {
+		return false, err
+	}
+
+	return !next.After(now), nil
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+)
+
+// validateWorkspaceSecrets checks that the secrets chosen by the parameters for the secrets declared in the manifest
+// exist in the namespace, along with the keys to inject.
+func (c *Client) validateWorkspaceSecrets(namespace, manifest string, parameters []Parameter) error {
+	spec, err := parseWorkspaceSpec(manifest)
+	if err != nil {
+		return util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+	if len(spec.Secrets) == 0 {
+		return nil
+	}
+
+	secrets, err := c.ListSecrets(namespace)
+	if err != nil {
+		return err
+	}
+	secretNames := make(map[string]bool)
+	for _, secret := range secrets {
+		secretNames[secret.Name] = true
+	}
+
+	for _, declaration := range spec.Secrets {
+		name := declaration.Secret
+		for _, p := range parameters {
+			if p.Name == declaration.ParameterName() && p.Value != nil {
+				name = *p.Value
+			}
+		}
+
+		if name == "" {
+			return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("A secret must be chosen for \"%v\".", declaration.Name))
+		}
+		if !secretNames[name] {
+			return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Secret \"%v\" does not exist.", name))
+		}
+
+		if len(declaration.Keys) == 0 {
+			continue
+		}
+
+		secret, err := c.GetSecret(namespace, name)
+		if err != nil {
+			return err
+		}
+		for _, key := range declaration.Keys {
+			if _, ok := secret.Data[key]; !ok {
+				return util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Secret \"%v\" does not have key \"%v\".", name, key))
+			}
+		}
+	}
+
+	return nil
+}
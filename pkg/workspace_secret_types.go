@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/onepanelio/core/pkg/util/ptr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// WorkspaceSecret declares a secret of the namespace that is injected into the containers of a workspace.
+// The secret is chosen when the workspace is created, with the parameter named by ParameterName.
+type WorkspaceSecret struct {
+	// Name identifies the declaration in the template, e.g. aws
+	Name        string  `json:"name"`
+	DisplayName *string `json:"displayName"`
+	Hint        *string `json:"hint"`
+	// Secret is the name of the secret used when none is chosen
+	Secret string `json:"secret"`
+	// Keys are the keys of the secret to inject. All of them are injected if there are none.
+	Keys []string `json:"keys"`
+	// Env injects the keys as environment variables named after them
+	Env bool `json:"env"`
+	// MountPath injects the keys as files named after them in this directory
+	MountPath string `json:"mountPath"`
+	// Containers are the names of the containers to inject the secret into. It is injected into all of them if there are none.
+	Containers []string `json:"containers"`
+}
+
+// ParameterName returns the name of the parameter with the name of the secret to inject
+func (s *WorkspaceSecret) ParameterName() string {
+	return "sys-secret-" + s.Name
+}
+
+// volumeName returns the name of the volume with the files of the secret
+func (s *WorkspaceSecret) volumeName() string {
+	return "sys-secret-" + s.Name
+}
+
+// secretName returns the name of the secret to inject, as a parameter of the workspace workflow
+func (s *WorkspaceSecret) secretName() string {
+	return fmt.Sprintf("{{workflow.parameters.%v}}", s.ParameterName())
+}
+
+// injectsInto returns true if the secret is injected into the container
+func (s *WorkspaceSecret) injectsInto(containerName string) bool {
+	if len(s.Containers) == 0 {
+		return true
+	}
+
+	for _, name := range s.Containers {
+		if name == containerName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validate checks that the declaration can be injected
+func (s *WorkspaceSecret) validate() error {
+	if errs := validation.IsDNS1123Label(s.Name); len(errs) > 0 {
+		return fmt.Errorf("secret name \"%v\" is invalid: %v", s.Name, errs[0])
+	}
+
+	if !s.Env && s.MountPath == "" {
+		return fmt.Errorf("secret \"%v\" must set env or mountPath", s.Name)
+	}
+
+	return nil
+}
+
+// parameter returns the parameter to choose the secret to inject
+func (s *WorkspaceSecret) parameter() Parameter {
+	displayName := s.DisplayName
+	if displayName == nil {
+		displayName = ptr.String(s.Name)
+	}
+
+	hint := s.Hint
+	if hint == nil {
+		hint = ptr.String("Name of the secret in the namespace to inject into the workspace")
+	}
+
+	return Parameter{
+		Name:        s.ParameterName(),
+		Type:        "input.text",
+		Value:       ptr.String(s.Secret),
+		DisplayName: displayName,
+		Hint:        hint,
+		Required:    true,
+	}
+}
+
+// inject adds the secret to the environment variables or volume mounts of the container
+func (s *WorkspaceSecret) inject(container *corev1.Container) {
+	if s.Env {
+		if len(s.Keys) == 0 {
+			container.EnvFrom = append(container.EnvFrom, corev1.EnvFromSource{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: s.secretName(),
+					},
+				},
+			})
+		}
+
+		for _, key := range s.Keys {
+			container.Env = append(container.Env, corev1.EnvVar{
+				Name: key,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: s.secretName(),
+						},
+						Key: key,
+					},
+				},
+			})
+		}
+	}
+
+	if s.MountPath != "" {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      s.volumeName(),
+			MountPath: s.MountPath,
+			ReadOnly:  true,
+		})
+	}
+}
+
+// volume returns the volume with the files of the secret, or nil if it is not injected as files
+func (s *WorkspaceSecret) volume() *corev1.Volume {
+	if s.MountPath == "" {
+		return nil
+	}
+
+	source := &corev1.SecretVolumeSource{
+		SecretName: s.secretName(),
+	}
+	for _, key := range s.Keys {
+		source.Items = append(source.Items, corev1.KeyToPath{
+			Key:  key,
+			Path: key,
+		})
+	}
+
+	return &corev1.Volume{
+		Name: s.volumeName(),
+		VolumeSource: corev1.VolumeSource{
+			Secret: source,
+		},
+	}
+}
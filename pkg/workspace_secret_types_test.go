@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestWorkspaceSecret_validate(t *testing.T) {
+	assert.Nil(t, (&WorkspaceSecret{Name: "aws", Env: true}).validate())
+	assert.NotNil(t, (&WorkspaceSecret{Name: "aws"}).validate())
+	assert.NotNil(t, (&WorkspaceSecret{Name: "AWS Keys", MountPath: "/etc/aws"}).validate())
+}
+
+func TestWorkspaceSecret_inject(t *testing.T) {
+	secret := &WorkspaceSecret{
+		Name:      "aws",
+		Secret:    "aws-credentials",
+		Keys:      []string{"AWS_ACCESS_KEY_ID"},
+		Env:       true,
+		MountPath: "/etc/aws",
+	}
+
+	container := &corev1.Container{Name: "jupyterlab"}
+	secret.inject(container)
+
+	assert.Len(t, container.EnvFrom, 0)
+	assert.Len(t, container.Env, 1)
+	assert.Equal(t, "AWS_ACCESS_KEY_ID", container.Env[0].ValueFrom.SecretKeyRef.Key)
+	assert.Equal(t, "{{workflow.parameters.sys-secret-aws}}", container.Env[0].ValueFrom.SecretKeyRef.Name)
+	assert.Len(t, container.VolumeMounts, 1)
+	assert.Equal(t, "/etc/aws", container.VolumeMounts[0].MountPath)
+
+	volume := secret.volume()
+	assert.NotNil(t, volume)
+	assert.Equal(t, container.VolumeMounts[0].Name, volume.Name)
+	assert.Len(t, volume.Secret.Items, 1)
+
+	parameter := secret.parameter()
+	assert.Equal(t, "sys-secret-aws", parameter.Name)
+	assert.Equal(t, "aws-credentials", *parameter.Value)
+}
+
+func TestWorkspaceSecret_injectAllKeys(t *testing.T) {
+	secret := &WorkspaceSecret{Name: "aws", Env: true, Containers: []string{"jupyterlab"}}
+
+	assert.True(t, secret.injectsInto("jupyterlab"))
+	assert.False(t, secret.injectsInto("sys-filesyncer"))
+
+	container := &corev1.Container{Name: "jupyterlab"}
+	secret.inject(container)
+
+	assert.Len(t, container.EnvFrom, 1)
+	assert.Len(t, container.Env, 0)
+	assert.Nil(t, secret.volume())
+}
+
+func Test_createStatefulSetManifestSecrets(t *testing.T) {
+	spec := &WorkspaceSpec{
+		Containers: []corev1.Container{{Name: "jupyterlab"}},
+		Secrets: []*WorkspaceSecret{
+			{Name: "aws", MountPath: "/etc/aws"},
+		},
+	}
+
+	manifest, err := createStatefulSetManifest(spec)
+	assert.Nil(t, err)
+	assert.Contains(t, manifest, "secretName: '{{workflow.parameters.sys-secret-aws}}'")
+	assert.NotContains(t, manifest, "sys-sys-secret-aws-volume-size")
+}
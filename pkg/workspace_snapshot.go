@@ -0,0 +1,386 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/label"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	uid2 "github.com/onepanelio/core/pkg/util/uid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+// volumeSnapshotRestClient returns a client for VolumeSnapshots that authenticates with the token of the client.
+// The config of the client already has the kubernetes timeout.
+func (c *Client) volumeSnapshotRestClient() (*rest.RESTClient, error) {
+	config := *c.config
+	config.GroupVersion = &schema.GroupVersion{Group: volumeSnapshotGroup, Version: volumeSnapshotVersion}
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	return rest.RESTClientFor(&config)
+}
+
+// workspaceSnapshotVolumeClaims returns persistent volume claims for the workspace that are populated from the snapshot
+func workspaceSnapshotVolumeClaims(workspaceUID string, snapshot *WorkspaceSnapshot) ([]*corev1.PersistentVolumeClaim, error) {
+	claims := make([]*corev1.PersistentVolumeClaim, 0)
+	for _, volume := range snapshot.Volumes {
+		storage, err := resource.ParseQuantity(volume.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		claims = append(claims, &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: workspaceVolumeClaimName(volume.ClaimName, workspaceUID),
+				Labels: map[string]string{
+					"app": workspaceUID,
+				},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      volume.AccessModes,
+				StorageClassName: volume.StorageClassName,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: storage,
+					},
+				},
+				DataSource: &corev1.TypedLocalObjectReference{
+					APIGroup: ptr.String(volumeSnapshotGroup),
+					Kind:     volumeSnapshotKind,
+					Name:     volume.SnapshotName,
+				},
+			},
+		})
+	}
+
+	return claims, nil
+}
+
+// restoreWorkspaceVolumeClaims populates the persistent volume claims of the workspace from the snapshot.
+// If replace is true, the existing claims of the workspace are replaced, see replaceWorkspaceVolumeClaim.
+// Otherwise, the workspace is new and the claims are created before it.
+func (c *Client) restoreWorkspaceVolumeClaims(namespace, workspaceUID string, snapshot *WorkspaceSnapshot, replace bool) error {
+	claims, err := workspaceSnapshotVolumeClaims(workspaceUID, snapshot)
+	if err == nil {
+		if replace {
+			err = c.replaceWorkspaceVolumeClaims(namespace, claims)
+		} else {
+			err = c.createWorkspaceVolumeClaims(namespace, claims)
+		}
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Workspace": workspaceUID,
+			"Snapshot":  snapshot.UID,
+			"Error":     err.Error(),
+		}).Error("Unable to restore workspace volumes.")
+		return util.NewUserError(codes.Internal, "Unable to restore workspace volumes.")
+	}
+
+	return nil
+}
+
+// loadWorkspaceSnapshotReadiness sets ReadyToUse on the volumes of the snapshots from their VolumeSnapshots
+func (c *Client) loadWorkspaceSnapshotReadiness(namespace string, snapshots ...*WorkspaceSnapshot) error {
+	restClient, err := c.volumeSnapshotRestClient()
+	if err != nil {
+		return err
+	}
+
+	for _, snapshot := range snapshots {
+		for _, volume := range snapshot.Volumes {
+			data, err := restClient.Get().
+				Namespace(namespace).
+				Name(volume.SnapshotName).
+				Resource(volumeSnapshotResource).
+				DoRaw()
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+
+				return err
+			}
+
+			result := &volumeSnapshot{}
+			if err := json.Unmarshal(data, result); err != nil {
+				return err
+			}
+
+			volume.ReadyToUse = result.Status != nil && result.Status.ReadyToUse != nil && *result.Status.ReadyToUse
+		}
+	}
+
+	return nil
+}
+
+// workspaceSnapshotsSelectBuilder selects the snapshots in a namespace, with the uid and template of their workspace
+func workspaceSnapshotsSelectBuilder(namespace string) sq.SelectBuilder {
+	return sb.Select(getWorkspaceSnapshotColumns("s")...).
+		Columns(`w.uid "workspace_uid"`, "w.workspace_template_id").
+		From("workspace_snapshots s").
+		Join("workspaces w ON w.id = s.workspace_id").
+		Where(sq.Eq{
+			"s.namespace": namespace,
+		})
+}
+
+// GetWorkspaceSnapshot returns a workspace snapshot, including whether its volumes are ready to use
+func (c *Client) GetWorkspaceSnapshot(namespace, uid string) (*WorkspaceSnapshot, error) {
+	query := workspaceSnapshotsSelectBuilder(namespace).
+		Where(sq.Eq{
+			"s.uid": uid,
+		})
+
+	snapshot := &WorkspaceSnapshot{}
+	if err := c.DB.Getx(snapshot, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Workspace snapshot not found.")
+		}
+
+		return nil, err
+	}
+
+	if err := c.loadWorkspaceSnapshotReadiness(namespace, snapshot); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// ListWorkspaceSnapshots returns the snapshots of a workspace, newest first, including whether their volumes are ready to use
+func (c *Client) ListWorkspaceSnapshots(namespace, workspaceUID string) (snapshots []*WorkspaceSnapshot, err error) {
+	query := workspaceSnapshotsSelectBuilder(namespace).
+		Where(sq.Eq{
+			"w.uid": workspaceUID,
+		}).
+		OrderBy("s.created_at DESC", "s.id DESC")
+
+	snapshots = make([]*WorkspaceSnapshot, 0)
+	if err := c.DB.Selectx(&snapshots, query); err != nil {
+		return nil, err
+	}
+
+	if err := c.loadWorkspaceSnapshotReadiness(namespace, snapshots...); err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// CreateWorkspaceSnapshot creates a VolumeSnapshot of each persistent volume claim of the workspace.
+// If name is empty, one is generated from the current time.
+func (c *Client) CreateWorkspaceSnapshot(namespace, workspaceUID, name string) (*WorkspaceSnapshot, error) {
+	workspace, err := c.GetWorkspace(namespace, workspaceUID)
+	if err != nil {
+		return nil, err
+	}
+	if workspace == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	if name == "" {
+		name = "snapshot-" + time.Now().UTC().Format("20060102-150405")
+	}
+
+	uid, err := uid2.GenerateUID(name, 30)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	claims, err := c.listWorkspaceVolumeClaims(namespace, workspace.UID)
+	if err != nil {
+		return nil, err
+	}
+	if len(claims) == 0 {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Workspace has no volumes to snapshot.")
+	}
+
+	snapshot := &WorkspaceSnapshot{
+		UID:                 uid,
+		Name:                name,
+		Namespace:           namespace,
+		WorkspaceID:         workspace.ID,
+		WorkspaceUID:        workspace.UID,
+		WorkspaceTemplateID: workspace.WorkspaceTemplateID,
+		Volumes:             make(WorkspaceSnapshotVolumes, 0),
+	}
+
+	// The row is inserted in a transaction that is only committed once all the VolumeSnapshots are created,
+	// so a failed snapshot is not listed. The row still claims the uid while the VolumeSnapshots are created.
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	err = sb.Insert("workspace_snapshots").
+		SetMap(sq.Eq{
+			"uid":          snapshot.UID,
+			"name":         snapshot.Name,
+			"namespace":    namespace,
+			"workspace_id": snapshot.WorkspaceID,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(tx).
+		QueryRow().
+		Scan(&snapshot.ID, &snapshot.CreatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, util.NewUserError(codes.AlreadyExists, "Workspace snapshot already exists.")
+		}
+
+		return nil, err
+	}
+
+	restClient, err := c.volumeSnapshotRestClient()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, claim := range claims {
+		claimName, _ := workspaceVolumeClaimTemplateName(claim.Name, workspace.UID)
+		volume := &WorkspaceSnapshotVolume{
+			ClaimName:        claimName,
+			SnapshotName:     fmt.Sprintf("%v-%v", snapshot.UID, claimName),
+			StorageClassName: claim.Spec.StorageClassName,
+			AccessModes:      claim.Spec.AccessModes,
+		}
+
+		// A restored volume can not be smaller than the volume in the snapshot
+		if storage, ok := workspaceVolumeClaimStorage(&claim); ok {
+			volume.Storage = storage.String()
+		}
+
+		data, err := json.Marshal(&volumeSnapshot{
+			APIVersion: volumeSnapshotGroup + "/" + volumeSnapshotVersion,
+			Kind:       volumeSnapshotKind,
+			Metadata: metav1.ObjectMeta{
+				Name: volume.SnapshotName,
+				Labels: map[string]string{
+					label.WorkspaceUid:         workspace.UID,
+					label.WorkspaceSnapshotUid: snapshot.UID,
+				},
+			},
+			Spec: volumeSnapshotSpec{
+				Source: volumeSnapshotSource{
+					PersistentVolumeClaimName: ptr.String(claim.Name),
+				},
+			},
+		})
+		if err != nil {
+			deleteVolumeSnapshots(restClient, namespace, snapshot.Volumes)
+			return nil, err
+		}
+
+		err = restClient.Post().
+			Namespace(namespace).
+			Resource(volumeSnapshotResource).
+			Body(data).
+			Do().
+			Error()
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Workspace": workspace.UID,
+				"Claim":     claim.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to create volume snapshot.")
+			deleteVolumeSnapshots(restClient, namespace, snapshot.Volumes)
+			return nil, util.NewUserError(codes.Internal, "Unable to create volume snapshot.")
+		}
+
+		snapshot.Volumes = append(snapshot.Volumes, volume)
+	}
+
+	_, err = sb.Update("workspace_snapshots").
+		Set("volumes", snapshot.Volumes).
+		Where(sq.Eq{"id": snapshot.ID}).
+		RunWith(tx).
+		Exec()
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		deleteVolumeSnapshots(restClient, namespace, snapshot.Volumes)
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// deleteVolumeSnapshots deletes the VolumeSnapshots of the volumes of a snapshot that could not be created.
+// Errors are logged, as the snapshot has already failed.
+func deleteVolumeSnapshots(restClient *rest.RESTClient, namespace string, volumes WorkspaceSnapshotVolumes) {
+	for _, volume := range volumes {
+		err := restClient.Delete().
+			Namespace(namespace).
+			Name(volume.SnapshotName).
+			Resource(volumeSnapshotResource).
+			Do().
+			Error()
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Snapshot":  volume.SnapshotName,
+				"Error":     err.Error(),
+			}).Error("Unable to delete volume snapshot.")
+		}
+	}
+}
+
+// getRestorableWorkspaceSnapshot returns the snapshot if it can be restored to a workspace of the workspace template
+func (c *Client) getRestorableWorkspaceSnapshot(namespace, snapshotUID string, workspaceTemplateID uint64) (*WorkspaceSnapshot, error) {
+	snapshot, err := c.GetWorkspaceSnapshot(namespace, snapshotUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.WorkspaceTemplateID != workspaceTemplateID {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Workspace snapshot is of a workspace with a different template.")
+	}
+
+	if !snapshot.IsReady() {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Workspace snapshot is not ready to be restored.")
+	}
+
+	return snapshot, nil
+}
+
+// RestoreWorkspaceSnapshot replaces the volumes of a paused workspace with the volumes in the snapshot.
+// The workspace uses the restored volumes once it is resumed. A volume that can not be restored keeps its data.
+func (c *Client) RestoreWorkspaceSnapshot(namespace, workspaceUID, snapshotUID string) error {
+	workspace, err := c.GetWorkspace(namespace, workspaceUID)
+	if err != nil {
+		return err
+	}
+	if workspace == nil {
+		return util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	if workspace.Status.Phase != WorkspacePaused {
+		return util.NewUserError(codes.FailedPrecondition, "Workspace must be paused to restore a snapshot.")
+	}
+
+	snapshot, err := c.getRestorableWorkspaceSnapshot(namespace, snapshotUID, workspace.WorkspaceTemplateID)
+	if err != nil {
+		return err
+	}
+
+	return c.restoreWorkspaceVolumeClaims(namespace, workspace.UID, snapshot, true)
+}
@@ -0,0 +1,131 @@
+package v1
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/sql"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	volumeSnapshotResource = "volumesnapshots"
+	volumeSnapshotGroup    = "snapshot.storage.k8s.io"
+	volumeSnapshotVersion  = "v1beta1"
+	volumeSnapshotKind     = "VolumeSnapshot"
+)
+
+// WorkspaceSnapshotVolume is the snapshot of one persistent volume claim of a workspace
+type WorkspaceSnapshotVolume struct {
+	// ClaimName is the name of the volume claim template in the workspace, e.g. data
+	ClaimName        string                              `json:"claimName"`
+	SnapshotName     string                              `json:"snapshotName"`
+	StorageClassName *string                             `json:"storageClassName,omitempty"`
+	AccessModes      []corev1.PersistentVolumeAccessMode `json:"accessModes"`
+	Storage          string                              `json:"storage"`
+	// ReadyToUse is loaded from the VolumeSnapshot and is not stored
+	ReadyToUse bool `json:"-"`
+}
+
+// WorkspaceSnapshotVolumes is the list of volumes of a WorkspaceSnapshot
+type WorkspaceSnapshotVolumes []*WorkspaceSnapshotVolume
+
+// Value returns v as json.
+// This is to support WorkspaceSnapshotVolumes working with JSONB column types in sql
+func (v WorkspaceSnapshotVolumes) Value() (driver.Value, error) {
+	if v == nil {
+		return json.Marshal(make([]*WorkspaceSnapshotVolume, 0))
+	}
+
+	return json.Marshal(v)
+}
+
+// Scan stores the src in v.  No validation is done.
+// This is to support WorkspaceSnapshotVolumes working with JSONB column types in sql
+func (v *WorkspaceSnapshotVolumes) Scan(src interface{}) error {
+	var source []byte
+	switch t := src.(type) {
+	case string:
+		source = []byte(t)
+	case []byte:
+		if len(t) == 0 {
+			source = []byte("[]")
+		} else {
+			source = t
+		}
+	case nil:
+		*v = make([]*WorkspaceSnapshotVolume, 0)
+		return nil
+	default:
+		return errors.New("incompatible type for WorkspaceSnapshotVolumes")
+	}
+
+	return json.Unmarshal(source, v)
+}
+
+// WorkspaceSnapshot is a set of Kubernetes VolumeSnapshots, one for each persistent volume claim of a workspace
+type WorkspaceSnapshot struct {
+	ID                  uint64
+	UID                 string
+	Name                string
+	Namespace           string
+	WorkspaceID         uint64 `db:"workspace_id"`
+	WorkspaceUID        string `db:"workspace_uid"`
+	WorkspaceTemplateID uint64 `db:"workspace_template_id"`
+	Volumes             WorkspaceSnapshotVolumes
+	CreatedAt           time.Time `db:"created_at"`
+}
+
+// IsReady returns true if the snapshot has volumes and all of them are ready to be restored
+func (s *WorkspaceSnapshot) IsReady() bool {
+	if len(s.Volumes) == 0 {
+		return false
+	}
+
+	for _, volume := range s.Volumes {
+		if !volume.ReadyToUse {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getWorkspaceSnapshotColumns returns all of the columns for workspace_snapshots modified by alias, destination.
+// see formatColumnSelect
+func getWorkspaceSnapshotColumns(aliasAndDestination ...string) []string {
+	columns := []string{
+		"id",
+		"uid",
+		"name",
+		"namespace",
+		"workspace_id",
+		"volumes",
+		"created_at",
+	}
+	return sql.FormatColumnSelect(columns, aliasAndDestination...)
+}
+
+// volumeSnapshot is the part of a Kubernetes VolumeSnapshot used by workspace snapshots
+type volumeSnapshot struct {
+	APIVersion string                `json:"apiVersion"`
+	Kind       string                `json:"kind"`
+	Metadata   metav1.ObjectMeta     `json:"metadata"`
+	Spec       volumeSnapshotSpec    `json:"spec"`
+	Status     *volumeSnapshotStatus `json:"status,omitempty"`
+}
+
+type volumeSnapshotSpec struct {
+	Source volumeSnapshotSource `json:"source"`
+}
+
+type volumeSnapshotSource struct {
+	PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+}
+
+type volumeSnapshotStatus struct {
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+}
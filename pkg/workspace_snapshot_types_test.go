@@ -0,0 +1,40 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WorkspaceSnapshotVolumes_ValueScan(t *testing.T) {
+	volumes := WorkspaceSnapshotVolumes{
+		{ClaimName: "data", SnapshotName: "snapshot-data", Storage: "20Gi", ReadyToUse: true},
+	}
+
+	value, err := volumes.Value()
+	assert.Nil(t, err)
+
+	scanned := WorkspaceSnapshotVolumes{}
+	assert.Nil(t, scanned.Scan(value))
+	assert.Len(t, scanned, 1)
+	assert.Equal(t, "snapshot-data", scanned[0].SnapshotName)
+	assert.False(t, scanned[0].ReadyToUse)
+
+	assert.Nil(t, scanned.Scan(nil))
+	assert.Empty(t, scanned)
+}
+
+// Test_WorkspaceSnapshot_IsReady makes sure a snapshot is only ready if it has volumes and they are all ready
+func Test_WorkspaceSnapshot_IsReady(t *testing.T) {
+	snapshot := &WorkspaceSnapshot{}
+	assert.False(t, snapshot.IsReady())
+
+	snapshot.Volumes = WorkspaceSnapshotVolumes{
+		{ClaimName: "data", ReadyToUse: true},
+		{ClaimName: "cache", ReadyToUse: false},
+	}
+	assert.False(t, snapshot.IsReady())
+
+	snapshot.Volumes[1].ReadyToUse = true
+	assert.True(t, snapshot.IsReady())
+}
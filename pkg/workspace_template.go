@@ -12,11 +12,13 @@ import (
 	"github.com/onepanelio/core/pkg/util/ptr"
 	"github.com/onepanelio/core/pkg/util/request"
 	"github.com/onepanelio/core/pkg/util/router"
+	"github.com/onepanelio/core/pkg/util/types"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	networking "istio.io/api/networking/v1alpha3"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"net/http"
 	"sigs.k8s.io/yaml"
 	"strings"
@@ -46,6 +48,9 @@ const WorkspaceDAGTemplateDeleteWorkspace = "delete-workspace"
 // WorkspaceDAGTemplateDeletePVC is used inside the DAG creation for the workspace.
 const WorkspaceDAGTemplateDeletePVC = "delete-pvc"
 
+// WorkspaceDAGTemplateWaitForServices is used inside the DAG creation for the workspace.
+const WorkspaceDAGTemplateWaitForServices = "sys-wait-for-services"
+
 // WorkspaceDAGTemplateSysSetPhaseRunning is used inside the DAG creation for the workspace.
 const WorkspaceDAGTemplateSysSetPhaseRunning = "sys-set-phase-running"
 
@@ -76,6 +81,15 @@ const WorkspaceResource = "workspace-resource"
 // WorkspaceDeletePVCResource is resource used in workspace template creation.
 const WorkspaceDeletePVCResource = "delete-pvc-resource"
 
+// WorkspaceWaitForServicesResource is resource used in workspace template creation.
+const WorkspaceWaitForServicesResource = "wait-for-services-resource"
+
+// workspaceEntrypointTemplate is the name of the entrypoint DAG template of a workspace workflow
+const workspaceEntrypointTemplate = "workspace"
+
+// workspaceServicesTimeoutSeconds is how long a workspace waits for its services to respond before it fails to launch
+const workspaceServicesTimeoutSeconds = 600
+
 // WorkspaceTemplateFilter represents the available ways we can filter WorkspaceTemplates
 type WorkspaceTemplateFilter struct {
 	Labels []*Label
@@ -144,6 +158,37 @@ func markWorkspaceTemplateVersionsOutdatedDB(tx sq.BaseRunner, workspaceTemplate
 	return
 }
 
+// setLatestWorkspaceTemplateVersionDB marks the version as the only latest version of the workspace template
+func setLatestWorkspaceTemplateVersionDB(tx sq.BaseRunner, workspaceTemplateID uint64, version int64) (err error) {
+	_, err = sb.Update("workspace_template_versions").
+		Set("is_latest", sq.Expr("version = ?", version)).
+		Where(sq.Eq{
+			"workspace_template_id": workspaceTemplateID,
+		}).
+		RunWith(tx).
+		Exec()
+
+	return
+}
+
+// setLatestWorkspaceTemplateVersion marks the version as the only latest version of the workspace template
+// and updates the labels of the workspace template to the ones of the version
+func setLatestWorkspaceTemplateVersion(runner sq.BaseRunner, workspaceTemplateID uint64, version int64, labels types.JSONLabels) error {
+	if err := setLatestWorkspaceTemplateVersionDB(runner, workspaceTemplateID, version); err != nil {
+		return err
+	}
+
+	_, err := sb.Update("workspace_templates").
+		Set("labels", labels).
+		Where(sq.Eq{
+			"id": workspaceTemplateID,
+		}).
+		RunWith(runner).
+		Exec()
+
+	return err
+}
+
 // createLatestWorkspaceTemplateVersionDB creates a new workspace template version and marks all previous versions as not latest.
 func createLatestWorkspaceTemplateVersionDB(tx sq.BaseRunner, template *WorkspaceTemplate) (err error) {
 	if template == nil {
@@ -288,6 +333,10 @@ func generateArguments(spec *WorkspaceSpec, config SystemConfig) (err error) {
 
 	spec.Arguments.Parameters = append(spec.Arguments.Parameters, systemVolumeParameters...)
 
+	for _, secret := range spec.Secrets {
+		spec.Arguments.Parameters = append(spec.Arguments.Parameters, secret.parameter())
+	}
+
 	return
 }
 
@@ -384,10 +433,24 @@ func createStatefulSetManifest(spec *WorkspaceSpec) (statefulSetManifest string,
 	volumeClaimsMapped["sys-dshm"] = true
 	volumeClaimsMapped["sys-namespace-config"] = true
 
+	var secretVolumes []corev1.Volume
+	for _, secret := range spec.Secrets {
+		if volume := secret.volume(); volume != nil {
+			secretVolumes = append(secretVolumes, *volume)
+			volumeClaimsMapped[volume.Name] = true
+		}
+	}
+
 	// Automatically map the remaining ones
 	for i, c := range spec.Containers {
 		container := &spec.Containers[i]
 
+		for _, secret := range spec.Secrets {
+			if secret.injectsInto(container.Name) {
+				secret.inject(container)
+			}
+		}
+
 		for _, v := range c.VolumeMounts {
 			if volumeClaimsMapped[v.Name] {
 				continue
@@ -469,6 +532,8 @@ func createStatefulSetManifest(spec *WorkspaceSpec) (statefulSetManifest string,
 		},
 	}
 
+	template.Spec.Volumes = append(template.Spec.Volumes, secretVolumes...)
+
 	statefulSet := map[string]interface{}{
 		"apiVersion": "apps/v1",
 		"kind":       "StatefulSet",
@@ -515,7 +580,52 @@ func createWorkspaceManifest(spec *WorkspaceSpec) (workspaceManifest string, err
 	return
 }
 
-func unmarshalWorkflowTemplate(spec *WorkspaceSpec, serviceManifest, virtualServiceManifest, statefulSetManifest, workspaceManifest string) (workflowTemplateSpecManifest string, err error) {
+// workspaceServiceURL returns the url of the service inside the cluster, for a workspace workflow
+func workspaceServiceURL(service *WorkspaceService) string {
+	return fmt.Sprintf("http://{{workflow.parameters.sys-uid}}.{{workflow.namespace}}.svc.cluster.local:%v%v", service.Port, service.Path)
+}
+
+// createWaitForServicesTemplate creates a template that waits until every service responds with a status below 500
+func createWaitForServicesTemplate(services []*WorkspaceService) *wfv1.Template {
+	urls := make([]string, 0)
+	for _, service := range services {
+		// A service that is not routed to a port can not be waited for
+		if service.Port == 0 {
+			continue
+		}
+
+		urls = append(urls, "'"+workspaceServiceURL(service)+"'")
+	}
+
+	return &wfv1.Template{
+		Name:                  WorkspaceWaitForServicesResource,
+		ActiveDeadlineSeconds: &intstr.IntOrString{Type: intstr.Int, IntVal: workspaceServicesTimeoutSeconds},
+		Container: &corev1.Container{
+			Name:    "curl",
+			Image:   "curlimages/curl:7.73.0",
+			Command: []string{"sh", "-c"},
+			Args: []string{
+				"for url in " + strings.Join(urls, " ") + "; do " +
+					"until code=$(curl -s -o /dev/null -w '%{http_code}' --connect-timeout 5 \"$url\") && [ \"$code\" -gt 0 ] && [ \"$code\" -lt 500 ]; " +
+					"do sleep 5; done; " +
+					"done",
+			},
+		},
+	}
+}
+
+// workspaceEntrypointDAG returns the DAG of the entrypoint template of a workspace workflow, or nil if there is none
+func workspaceEntrypointDAG(templates []wfv1.Template) *wfv1.DAGTemplate {
+	for i := range templates {
+		if templates[i].Name == workspaceEntrypointTemplate {
+			return templates[i].DAG
+		}
+	}
+
+	return nil
+}
+
+func unmarshalWorkflowTemplate(spec *WorkspaceSpec, services []*WorkspaceService, serviceManifest, virtualServiceManifest, statefulSetManifest, workspaceManifest string) (workflowTemplateSpecManifest string, err error) {
 	var volumeClaimItems []wfv1.Item
 	volumeClaimsMapped := make(map[string]bool)
 	for _, c := range spec.Containers {
@@ -557,7 +667,7 @@ metadata:
 			},
 		},
 		{
-			Name: "workspace",
+			Name: workspaceEntrypointTemplate,
 			DAG: &wfv1.DAGTemplate{
 				FailFast: ptr.Bool(false),
 				Tasks: []wfv1.DAGTask{
@@ -775,6 +885,30 @@ metadata:
 		return
 	}
 	templates = append(templates, *curlNodeTemplate)
+
+	entrypointDAG := workspaceEntrypointDAG(templates)
+	if entrypointDAG == nil {
+		return "", fmt.Errorf("template %v not found", workspaceEntrypointTemplate)
+	}
+
+	// The workspace is only running once its services respond
+	if len(services) > 0 {
+		dag := entrypointDAG
+		dag.Tasks = append(dag.Tasks, wfv1.DAGTask{
+			Name:         WorkspaceDAGTemplateWaitForServices,
+			Template:     WorkspaceWaitForServicesResource,
+			Dependencies: []string{WorkspaceDAGTemplateCreateWorkspace},
+			When:         "{{workflow.parameters.sys-workspace-action}} == create || {{workflow.parameters.sys-workspace-action}} == update",
+		})
+		for i := range dag.Tasks {
+			if dag.Tasks[i].Name == WorkspaceDAGTemplateSysSetPhaseRunning {
+				dag.Tasks[i].Dependencies = []string{WorkspaceDAGTemplateWaitForServices}
+			}
+		}
+
+		templates = append(templates, *createWaitForServicesTemplate(services))
+	}
+
 	// Add postExecutionWorkflow if it exists
 	if spec.PostExecutionWorkflow != nil {
 		dag := wfv1.DAGTask{
@@ -783,14 +917,14 @@ metadata:
 			Dependencies: []string{WorkspaceDAGTemplateSysSetPhaseRunning, WorkspaceDAGTemplateSysSetPhasePaused, WorkspaceDAGTemplateSysSetPhaseTerminated},
 		}
 
-		templates[1].DAG.Tasks = append(templates[1].DAG.Tasks, dag)
+		entrypointDAG.Tasks = append(entrypointDAG.Tasks, dag)
 
 		templates = append(templates, spec.PostExecutionWorkflow.Templates...)
 	}
 
 	workflowTemplateSpec := map[string]interface{}{
 		"arguments":        spec.Arguments,
-		"entrypoint":       "workspace",
+		"entrypoint":       workspaceEntrypointTemplate,
 		"onExit":           "handleExit",
 		"templates":        templates,
 		"imagePullSecrets": spec.ImagePullSecrets,
@@ -945,6 +1079,12 @@ func (c *Client) generateWorkspaceTemplateWorkflowTemplate(workspaceTemplate *Wo
 		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
 	}
 
+	for _, secret := range workspaceSpec.Secrets {
+		if err := secret.validate(); err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	if workspaceSpec.Arguments != nil {
 		modifiedParameters, err := c.replaceSysNodePoolOptions(workspaceSpec.Arguments.Parameters)
 		if err != nil {
@@ -977,7 +1117,12 @@ func (c *Client) generateWorkspaceTemplateWorkflowTemplate(workspaceTemplate *Wo
 		return nil, err
 	}
 
-	workflowTemplateManifest, err := unmarshalWorkflowTemplate(workspaceSpec, serviceManifest, virtualServiceManifest, statefulSetManifest, workspaceManifest)
+	services, err := workspaceTemplate.GetRoutedServices()
+	if err != nil {
+		return nil, err
+	}
+
+	workflowTemplateManifest, err := unmarshalWorkflowTemplate(workspaceSpec, services, serviceManifest, virtualServiceManifest, statefulSetManifest, workspaceManifest)
 	if err != nil {
 		return nil, err
 	}
@@ -1161,6 +1306,87 @@ func (c *Client) UpdateWorkspaceTemplateManifest(namespace, uid string, manifest
 	return c.UpdateWorkspaceTemplate(namespace, existingTemplate)
 }
 
+// SetLatestWorkspaceTemplateVersion makes a previous version of the workspace template the latest one.
+// If clone is true, a new version is created with the manifest and labels of the version.
+// Otherwise, the version and its workflow template version are marked as latest, so new workspaces use them.
+func (c *Client) SetLatestWorkspaceTemplateVersion(namespace, uid string, version int64, clone bool) (*WorkspaceTemplate, error) {
+	if version == 0 {
+		return nil, util.NewUserError(codes.InvalidArgument, "Version is required.")
+	}
+
+	workspaceTemplate, err := c.GetWorkspaceTemplate(namespace, uid, version)
+	if err != nil {
+		return nil, err
+	}
+	if workspaceTemplate == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace template version not found.")
+	}
+
+	if clone {
+		return c.UpdateWorkspaceTemplate(namespace, &WorkspaceTemplate{
+			UID:         uid,
+			Manifest:    workspaceTemplate.Manifest,
+			Labels:      workspaceTemplate.Labels,
+			Description: workspaceTemplate.Description,
+		})
+	}
+
+	workflowTemplateVersion, err := c.getWorkflowTemplateVersionByUID(namespace, workspaceTemplate.WorkflowTemplate.UID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := c.GetWorkspaceTemplate(namespace, uid, 0)
+	if err != nil {
+		return nil, err
+	}
+	previousWorkflowTemplateVersion, err := c.getWorkflowTemplateVersionByUID(namespace, workspaceTemplate.WorkflowTemplate.UID, previous.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if err := setLatestWorkspaceTemplateVersion(tx, workspaceTemplate.ID, version, workspaceTemplate.Labels); err != nil {
+		return nil, err
+	}
+
+	if err := setLatestWorkflowTemplateVersion(tx, workflowTemplateVersion); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if err := c.setLatestArgoWorkflowTemplate(namespace, workflowTemplateVersion.WorkflowTemplate.UID, version); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Version":   version,
+			"Error":     err.Error(),
+		}).Error("Unable to set latest workspace template version.")
+
+		if err := setLatestWorkspaceTemplateVersion(c.DB, workspaceTemplate.ID, previous.Version, previous.Labels); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Version":   previous.Version,
+				"Error":     err.Error(),
+			}).Error("Unable to restore latest workspace template version.")
+		}
+		c.restoreLatestWorkflowTemplateVersion(namespace, previousWorkflowTemplateVersion)
+
+		return nil, util.NewUserError(codes.Internal, "Unable to set latest workspace template version.")
+	}
+
+	return c.GetWorkspaceTemplate(namespace, uid, version)
+}
+
 // ListWorkspaceTemplates returns a list of workspace templates that are not archived, sorted by most recent created first
 func (c *Client) ListWorkspaceTemplates(namespace string, request *request.Request) (workspaceTemplates []*WorkspaceTemplate, err error) {
 	sb := c.workspaceTemplatesSelectBuilder(namespace).
@@ -1168,29 +1394,24 @@ func (c *Client) ListWorkspaceTemplates(namespace string, request *request.Reque
 			"wt.is_archived": false,
 		})
 
-	if request.HasSorting() {
-		properties := getWorkspaceTemplateColumnsMap(true)
-		for _, order := range request.Sort.Properties {
-			if columnName, ok := properties[order.Property]; ok {
-				nullSort := "NULLS FIRST"
-				if order.Direction == "desc" {
-					nullSort = "NULLS LAST" // default in postgres, but let's be explicit
-				}
-				sb = sb.OrderBy(fmt.Sprintf("wt.%v %v %v", columnName, order.Direction, nullSort))
-			}
-		}
-	} else {
-		sb = sb.OrderBy("wt.created_at DESC")
-	}
-
 	sb, err = applyWorkspaceTemplateFilter(sb, request)
 	if err != nil {
 		return nil, err
 	}
 
-	sb = *request.ApplyPaginationToSelect(&sb)
+	keysetColumns := request.KeysetColumns("wt", getWorkspaceTemplateColumnsMap(true), nil)
+	paginatedSb, err := request.ApplyKeysetPaginationToSelect(&sb, keysetColumns)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	if err = c.DB.Selectx(&workspaceTemplates, *paginatedSb); err != nil {
+		return nil, err
+	}
 
-	err = c.DB.Selectx(&workspaceTemplates, sb)
+	if err := request.SetNextContinue(keysetColumns, workspaceTemplates); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
 
 	return
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/onepanelio/core/pkg/util/types"
 	uid2 "github.com/onepanelio/core/pkg/util/uid"
 	yaml3 "gopkg.in/yaml.v3"
+	networking "istio.io/api/networking/v1alpha3"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/yaml"
 	"strings"
 	"time"
@@ -17,6 +19,8 @@ import (
 type WorkspaceService struct {
 	Name string
 	Path string
+	// Port is the port of the workspace's Kubernetes Service the path is routed to
+	Port uint32
 }
 
 // WorkspaceTemplate represents the data associated with a WorkspaceTemplate
@@ -156,9 +160,125 @@ func (wt *WorkspaceTemplate) GetServices() ([]*WorkspaceService, error) {
 		}
 	}
 
+	if len(result) == 0 {
+		return result, nil
+	}
+
+	spec, err := parseWorkspaceSpec(wt.Manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range result {
+		service.Port = workspaceRoutePort(spec.Routes, service.Path)
+	}
+
 	return result, nil
 }
 
+// GetRoutedServices returns the services of GetServices and a service for each route of the workspace, like Jupyter or VSCode,
+// named after the port it is routed to. These are the services the workspace needs to be ready.
+// Services that are not routed to a port are left out, as they can not be probed.
+func (wt *WorkspaceTemplate) GetRoutedServices() ([]*WorkspaceService, error) {
+	services, err := wt.GetServices()
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := parseWorkspaceSpec(wt.Manifest)
+	if err != nil {
+		return nil, err
+	}
+	if spec == nil {
+		spec = &WorkspaceSpec{}
+	}
+
+	result := make([]*WorkspaceService, 0)
+	paths := make(map[string]bool)
+	for _, service := range services {
+		if service.Port == 0 {
+			continue
+		}
+
+		paths[service.Path] = true
+		result = append(result, service)
+	}
+
+	for _, route := range spec.Routes {
+		if len(route.Route) == 0 || route.Route[0].Destination == nil || route.Route[0].Destination.Port == nil {
+			continue
+		}
+
+		port := route.Route[0].Destination.Port.Number
+		if port == 0 {
+			continue
+		}
+
+		for _, match := range route.Match {
+			if match.Uri == nil {
+				continue
+			}
+
+			path := match.Uri.GetPrefix()
+			if path == "" {
+				path = match.Uri.GetExact()
+			}
+			if path == "" || paths[path] {
+				continue
+			}
+
+			paths[path] = true
+			result = append(result, &WorkspaceService{
+				Name: workspacePortName(spec.Ports, port),
+				Path: path,
+				Port: port,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+// workspacePortName returns the name of the port of the workspace's Kubernetes Service, or the port number if it has no name
+func workspacePortName(ports []corev1.ServicePort, port uint32) string {
+	for _, p := range ports {
+		if uint32(p.Port) == port && p.Name != "" {
+			return p.Name
+		}
+	}
+
+	return fmt.Sprintf("%v", port)
+}
+
+// workspaceRoutePort returns the destination port of the route with the longest uri prefix matching the path, or 0
+func workspaceRoutePort(routes []*networking.HTTPRoute, path string) (port uint32) {
+	longestPrefix := -1
+	for _, route := range routes {
+		if len(route.Route) == 0 || route.Route[0].Destination == nil || route.Route[0].Destination.Port == nil {
+			continue
+		}
+
+		for _, match := range route.Match {
+			if match.Uri == nil {
+				continue
+			}
+
+			prefix := match.Uri.GetPrefix()
+			if prefix == "" {
+				prefix = match.Uri.GetExact()
+			}
+			if prefix == "" || !strings.HasPrefix(path, prefix) || len(prefix) <= longestPrefix {
+				continue
+			}
+
+			longestPrefix = len(prefix)
+			port = route.Route[0].Destination.Port.Number
+		}
+	}
+
+	return port
+}
+
 // WorkspaceTemplatesToVersionIDs plucks the WorkspaceTemplateVersionID from each template and returns it in an array
 // No duplicates are included.
 func WorkspaceTemplatesToVersionIDs(resources []*WorkspaceTemplate) (ids []uint64) {
@@ -29,12 +29,28 @@ const (
 	WorkspaceFailedToUpdate    WorkspacePhase = "Failed to upgrade"
 )
 
+// Reasons a workspace was paused
+const (
+	WorkspacePausedByUser = "User"
+	WorkspacePausedIdle   = "Idle"
+)
+
 type WorkspaceStatus struct {
-	Phase        WorkspacePhase `db:"phase"`
-	StartedAt    *time.Time     `db:"started_at"`
-	PausedAt     *time.Time     `db:"paused_at"`
-	TerminatedAt *time.Time     `db:"terminated_at"`
-	UpdatedAt    *time.Time     `db:"updated_at"`
+	Phase          WorkspacePhase `db:"phase"`
+	StartedAt      *time.Time     `db:"started_at"`
+	PausedAt       *time.Time     `db:"paused_at"`
+	TerminatedAt   *time.Time     `db:"terminated_at"`
+	UpdatedAt      *time.Time     `db:"updated_at"`
+	PausedReason   *string        `db:"paused_reason"`
+	LastActivityAt *time.Time     `db:"last_activity_at"`
+}
+
+// WorkspaceServiceHealth is whether a service of a running workspace responds
+type WorkspaceServiceHealth struct {
+	Service *WorkspaceService
+	Ready   bool
+	// Message is why the service is not ready
+	Message string
 }
 
 type Workspace struct {
@@ -53,6 +69,10 @@ type Workspace struct {
 	WorkspaceTemplateVersion uint64                   `db:"workspace_template_version"`
 	WorkflowTemplateVersion  *WorkflowTemplateVersion `db:"workflow_template_version"` // helper to store data from workflow template version
 	CaptureNode              bool                     `db:"capture_node"`
+	IdleTimeoutMinutes       *int32                   `db:"idle_timeout_minutes"` // nil uses the default of the template, 0 disables pausing idle workspaces
+	Owner                    *string                  `db:"owner"`                // username of the creator, nil for workspaces created before they could be shared
+	SnapshotUID              string                   `db:"-"`                    // snapshot to restore the volumes of a new workspace from
+	SourceWorkspaceUID       string                   `db:"-"`                    // workspace to clone the volumes of a new workspace from
 }
 
 type WorkspaceSpec struct {
@@ -63,6 +83,8 @@ type WorkspaceSpec struct {
 	Routes                []*networking.HTTPRoute        `json:"routes" protobuf:"bytes,5,opt,name=routes"`
 	VolumeClaimTemplates  []corev1.PersistentVolumeClaim `json:"volumeClaimTemplates" protobuf:"bytes,6,opt,name=volumeClaimTemplates"`
 	PostExecutionWorkflow *wfv1.WorkflowTemplateSpec     `json:"postExecutionWorkflow" protobuf:"bytes,7,opt,name=postExecutionWorkflow"`
+	IdleTimeoutMinutes    *int32                         `json:"idleTimeoutMinutes"`
+	Secrets               []*WorkspaceSecret             `json:"secrets"`
 }
 
 // GetURL returns a url that can be used to access the workspace in a browser.
@@ -103,14 +125,14 @@ func GenerateWorkspaceUID(name string) (string, error) {
 // getWorkspaceColumns returns all of the columns for workspace modified by alias, destination.
 // see formatColumnSelect
 func getWorkspaceColumns(aliasAndDestination ...string) []string {
-	columns := []string{"id", "created_at", "modified_at", "uid", "name", "namespace", "parameters", "workspace_template_id", "workspace_template_version", "labels", "capture_node"}
+	columns := []string{"id", "created_at", "modified_at", "uid", "name", "namespace", "parameters", "workspace_template_id", "workspace_template_version", "labels", "capture_node", "idle_timeout_minutes", "owner"}
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
 
 // getWorkspaceStatusColumns returns all of the columns for WorkspaceStatus modified by alias, destination.
 // see formatColumnSelect
 func getWorkspaceStatusColumns(aliasAndDestination ...string) []string {
-	columns := []string{"phase", "started_at", "paused_at", "terminated_at"}
+	columns := []string{"phase", "started_at", "paused_at", "terminated_at", "paused_reason", "last_activity_at"}
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
 
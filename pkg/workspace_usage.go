@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+)
+
+// recordWorkspacePhaseTransition adds the phase to the history of the workspace, with the node pool in its parameters
+func (c *Client) recordWorkspacePhaseTransition(workspaceID uint64, phase WorkspacePhase, parametersBytes []byte) error {
+	var parameters []Parameter
+	if err := json.Unmarshal(parametersBytes, &parameters); err != nil {
+		return err
+	}
+
+	var nodePool *string
+	for _, p := range parameters {
+		if p.Name == "sys-node-pool" {
+			nodePool = p.Value
+		}
+	}
+
+	_, err := sb.Insert("workspace_phase_transitions").
+		SetMap(sq.Eq{
+			"workspace_id": workspaceID,
+			"phase":        phase,
+			"node_pool":    nodePool,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// GetWorkspaceUsageReport returns how long the workspaces of the namespace ran between start and end, per workspace,
+// per node pool and per value of the labelKey label. labelKey is optional.
+func (c *Client) GetWorkspaceUsageReport(namespace string, start, end time.Time, labelKey string) (*WorkspaceUsageReport, error) {
+	if !start.Before(end) {
+		return nil, util.NewUserError(codes.InvalidArgument, "Start must be before end.")
+	}
+
+	config, err := c.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	nodePoolOptions, err := config.NodePoolOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	nodePoolNames := make(map[string]string)
+	for _, option := range nodePoolOptions {
+		nodePoolNames[option.Value] = option.Name
+	}
+
+	// The latest transition of each workspace before start is needed to know which workspaces were already running at start
+	query := sb.Select("t.workspace_id", "w.uid", "w.name", "w.labels", "t.phase", "t.node_pool", "t.created_at").
+		From("workspace_phase_transitions t").
+		Join("workspaces w ON w.id = t.workspace_id").
+		Where(sq.And{
+			sq.Eq{
+				"w.namespace": namespace,
+			},
+			sq.Lt{
+				"t.created_at": end,
+			},
+			sq.Or{
+				sq.GtOrEq{
+					"t.created_at": start,
+				},
+				sq.Expr(`t.id = (
+					SELECT tb.id FROM workspace_phase_transitions tb
+					WHERE tb.workspace_id = t.workspace_id AND tb.created_at < ?
+					ORDER BY tb.created_at DESC, tb.id DESC
+					LIMIT 1
+				)`, start),
+			},
+		}).
+		OrderBy("t.workspace_id", "t.created_at", "t.id")
+
+	query, err = c.applyWorkspaceAccess(query)
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := make([]*workspacePhaseTransition, 0)
+	if err := c.DB.Selectx(&transitions, query); err != nil {
+		return nil, err
+	}
+
+	return newWorkspaceUsageReport(transitions, start, end, time.Now().UTC(), labelKey, nodePoolNames), nil
+}
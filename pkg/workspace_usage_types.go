@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"sort"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/types"
+)
+
+// WorkspaceUsage is how long workspaces ran, for one workspace, node pool or label value of a WorkspaceUsageReport
+type WorkspaceUsage struct {
+	// Key is the uid of the workspace, the value of the node pool or the value of the label
+	Key string
+	// Name is the name of the workspace or of the node pool option
+	Name         string
+	RunningHours float64
+}
+
+// WorkspaceUsageReport is how long the workspaces of a namespace ran between Start and End
+type WorkspaceUsageReport struct {
+	Start      time.Time
+	End        time.Time
+	LabelKey   string
+	Workspaces []*WorkspaceUsage
+	NodePools  []*WorkspaceUsage
+	// Labels groups the usage by the value of the LabelKey label of the workspaces
+	Labels []*WorkspaceUsage
+}
+
+// workspacePhaseTransition is a recorded change of the phase of a workspace, with the node pool it ran on at the time
+type workspacePhaseTransition struct {
+	WorkspaceID uint64 `db:"workspace_id"`
+	UID         string
+	Name        string
+	Labels      types.JSONLabels
+	Phase       WorkspacePhase
+	NodePool    *string   `db:"node_pool"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// workspaceUsageTotals adds up the hours in one grouping of a WorkspaceUsageReport
+type workspaceUsageTotals map[string]*WorkspaceUsage
+
+func (t workspaceUsageTotals) add(key, name string, hours float64) {
+	usage, ok := t[key]
+	if !ok {
+		usage = &WorkspaceUsage{
+			Key:  key,
+			Name: name,
+		}
+		t[key] = usage
+	}
+
+	usage.RunningHours += hours
+}
+
+// sorted returns the usages ordered by key
+func (t workspaceUsageTotals) sorted() []*WorkspaceUsage {
+	result := make([]*WorkspaceUsage, 0, len(t))
+	for _, usage := range t {
+		result = append(result, usage)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+
+	return result
+}
+
+// newWorkspaceUsageReport adds up the time each workspace was Running between start and end from its phase transitions.
+// The transitions must be ordered by workspace and time. A workspace that is still running at now is counted up to now.
+// nodePoolNames maps the value of a node pool to the name of its option.
+func newWorkspaceUsageReport(transitions []*workspacePhaseTransition, start, end, now time.Time, labelKey string, nodePoolNames map[string]string) *WorkspaceUsageReport {
+	if now.Before(end) {
+		end = now
+	}
+
+	workspaces := make(workspaceUsageTotals)
+	nodePools := make(workspaceUsageTotals)
+	labels := make(workspaceUsageTotals)
+
+	for i, transition := range transitions {
+		if transition.Phase != WorkspaceRunning {
+			continue
+		}
+
+		runningFrom := transition.CreatedAt
+		runningUntil := end
+		if i+1 < len(transitions) && transitions[i+1].WorkspaceID == transition.WorkspaceID && transitions[i+1].CreatedAt.Before(end) {
+			runningUntil = transitions[i+1].CreatedAt
+		}
+		if runningFrom.Before(start) {
+			runningFrom = start
+		}
+		if !runningUntil.After(runningFrom) {
+			continue
+		}
+
+		hours := runningUntil.Sub(runningFrom).Hours()
+
+		workspaces.add(transition.UID, transition.Name, hours)
+
+		nodePool := ""
+		if transition.NodePool != nil {
+			nodePool = *transition.NodePool
+		}
+		nodePools.add(nodePool, nodePoolNames[nodePool], hours)
+
+		if labelKey != "" {
+			labels.add(transition.Labels[labelKey], "", hours)
+		}
+	}
+
+	return &WorkspaceUsageReport{
+		Start:      start,
+		End:        end,
+		LabelKey:   labelKey,
+		Workspaces: workspaces.sorted(),
+		NodePools:  nodePools.sorted(),
+		Labels:     labels.sorted(),
+	}
+}
@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWorkspaceUsageReport(t *testing.T) {
+	start := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	now := end.Add(time.Hour)
+
+	transitions := []*workspacePhaseTransition{
+		// Running from before the start, paused after 2 hours of the range
+		{WorkspaceID: 1, UID: "a", Name: "A", Labels: map[string]string{"team": "vision"}, Phase: WorkspaceRunning, NodePool: ptr.String("gpu"), CreatedAt: start.Add(-time.Hour)},
+		{WorkspaceID: 1, UID: "a", Name: "A", Labels: map[string]string{"team": "vision"}, Phase: WorkspacePaused, NodePool: ptr.String("gpu"), CreatedAt: start.Add(2 * time.Hour)},
+		// Running for 3 hours on a gpu, then 1 hour on a cpu after being updated, then still running at the end
+		{WorkspaceID: 2, UID: "b", Name: "B", Labels: map[string]string{"team": "nlp"}, Phase: WorkspaceRunning, NodePool: ptr.String("gpu"), CreatedAt: start.Add(10 * time.Hour)},
+		{WorkspaceID: 2, UID: "b", Name: "B", Labels: map[string]string{"team": "nlp"}, Phase: WorkspaceUpdating, NodePool: ptr.String("gpu"), CreatedAt: start.Add(13 * time.Hour)},
+		{WorkspaceID: 2, UID: "b", Name: "B", Labels: map[string]string{"team": "nlp"}, Phase: WorkspaceRunning, NodePool: ptr.String("cpu"), CreatedAt: start.Add(23 * time.Hour)},
+		// Paused before the start
+		{WorkspaceID: 3, UID: "c", Name: "C", Phase: WorkspaceRunning, NodePool: ptr.String("cpu"), CreatedAt: start.Add(-3 * time.Hour)},
+		{WorkspaceID: 3, UID: "c", Name: "C", Phase: WorkspacePaused, NodePool: ptr.String("cpu"), CreatedAt: start.Add(-2 * time.Hour)},
+	}
+
+	report := newWorkspaceUsageReport(transitions, start, end, now, "team", map[string]string{"gpu": "GPU", "cpu": "CPU"})
+
+	assert.Len(t, report.Workspaces, 2)
+	assert.Equal(t, "a", report.Workspaces[0].Key)
+	assert.Equal(t, 2.0, report.Workspaces[0].RunningHours)
+	assert.Equal(t, "b", report.Workspaces[1].Key)
+	assert.Equal(t, 4.0, report.Workspaces[1].RunningHours)
+
+	assert.Len(t, report.NodePools, 2)
+	assert.Equal(t, "cpu", report.NodePools[0].Key)
+	assert.Equal(t, "CPU", report.NodePools[0].Name)
+	assert.Equal(t, 1.0, report.NodePools[0].RunningHours)
+	assert.Equal(t, "gpu", report.NodePools[1].Key)
+	assert.Equal(t, 5.0, report.NodePools[1].RunningHours)
+
+	assert.Len(t, report.Labels, 2)
+	assert.Equal(t, "nlp", report.Labels[0].Key)
+	assert.Equal(t, 4.0, report.Labels[0].RunningHours)
+	assert.Equal(t, "vision", report.Labels[1].Key)
+	assert.Equal(t, 2.0, report.Labels[1].RunningHours)
+}
+
+func TestNewWorkspaceUsageReport_StillRunning(t *testing.T) {
+	start := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	now := start.Add(5 * time.Hour)
+
+	transitions := []*workspacePhaseTransition{
+		{WorkspaceID: 1, UID: "a", Name: "A", Phase: WorkspaceRunning, CreatedAt: start.Add(time.Hour)},
+	}
+
+	report := newWorkspaceUsageReport(transitions, start, end, now, "", nil)
+
+	assert.Equal(t, now, report.End)
+	assert.Len(t, report.Workspaces, 1)
+	assert.Equal(t, 4.0, report.Workspaces[0].RunningHours)
+	assert.Len(t, report.Labels, 0)
+}
@@ -0,0 +1,305 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// workspaceVolumeClaimName returns the name of the persistent volume claim the workspace StatefulSet
+// creates for the volume claim template claimName
+func workspaceVolumeClaimName(claimName, workspaceUID string) string {
+	return fmt.Sprintf("%v-%v-0", claimName, workspaceUID)
+}
+
+// workspaceVolumeClaimTemplateName returns the name of the volume claim template of a persistent volume claim of
+// the workspace, or false if the persistent volume claim was not created for the workspace StatefulSet
+func workspaceVolumeClaimTemplateName(persistentVolumeClaimName, workspaceUID string) (string, bool) {
+	suffix := fmt.Sprintf("-%v-0", workspaceUID)
+	if !strings.HasSuffix(persistentVolumeClaimName, suffix) || len(persistentVolumeClaimName) == len(suffix) {
+		return "", false
+	}
+
+	return strings.TrimSuffix(persistentVolumeClaimName, suffix), true
+}
+
+// workspaceVolumeClaimStorage returns the size of a persistent volume claim: its capacity once bound, or the storage it requests
+func workspaceVolumeClaimStorage(claim *corev1.PersistentVolumeClaim) (resource.Quantity, bool) {
+	if storage, ok := claim.Status.Capacity[corev1.ResourceStorage]; ok {
+		return storage, true
+	}
+
+	storage, ok := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+
+	return storage, ok
+}
+
+// listWorkspaceVolumeClaims returns the persistent volume claims of the workspace StatefulSet
+func (c *Client) listWorkspaceVolumeClaims(namespace, workspaceUID string) ([]corev1.PersistentVolumeClaim, error) {
+	list, err := c.CoreV1().PersistentVolumeClaims(namespace).List(metav1.ListOptions{
+		LabelSelector: "app=" + workspaceUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claims := make([]corev1.PersistentVolumeClaim, 0)
+	for _, claim := range list.Items {
+		if _, ok := workspaceVolumeClaimTemplateName(claim.Name, workspaceUID); ok {
+			claims = append(claims, claim)
+		}
+	}
+
+	return claims, nil
+}
+
+// workspaceVolumeClaimBindTimeout is how long a restored persistent volume claim may take to bind to its volume
+const workspaceVolumeClaimBindTimeout = 5 * time.Minute
+
+// restoredWorkspaceVolumeClaimName returns the name a persistent volume claim is restored under until its volume is bound
+func restoredWorkspaceVolumeClaimName(claimName string) string {
+	return claimName + "-restored"
+}
+
+// waitForWorkspaceVolumeClaimDeleted waits until the persistent volume claim is gone, as deleting a claim is not immediate
+func (c *Client) waitForWorkspaceVolumeClaimDeleted(namespace, name string) error {
+	return wait.PollImmediate(time.Second, time.Minute, func() (bool, error) {
+		_, err := c.CoreV1().PersistentVolumeClaims(namespace).Get(name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+
+		return false, err
+	})
+}
+
+// deleteWorkspaceVolumeClaim deletes the persistent volume claim, if there is one, and waits until it is gone
+func (c *Client) deleteWorkspaceVolumeClaim(namespace, name string) error {
+	err := c.CoreV1().PersistentVolumeClaims(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	return c.waitForWorkspaceVolumeClaimDeleted(namespace, name)
+}
+
+// waitForWorkspaceVolumeClaimBound waits until the persistent volume claim is bound and returns the name of its volume
+func (c *Client) waitForWorkspaceVolumeClaimBound(namespace, name string) (volumeName string, err error) {
+	err = wait.PollImmediate(time.Second, workspaceVolumeClaimBindTimeout, func() (bool, error) {
+		claim, err := c.CoreV1().PersistentVolumeClaims(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if claim.Status.Phase != corev1.ClaimBound || claim.Spec.VolumeName == "" {
+			return false, nil
+		}
+
+		volumeName = claim.Spec.VolumeName
+		return true, nil
+	})
+
+	return
+}
+
+// setPersistentVolumeReclaimPolicy sets the reclaim policy of the persistent volume and returns the one it had
+func (c *Client) setPersistentVolumeReclaimPolicy(name string, policy corev1.PersistentVolumeReclaimPolicy) (corev1.PersistentVolumeReclaimPolicy, error) {
+	volume, err := c.CoreV1().PersistentVolumes().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	previousPolicy := volume.Spec.PersistentVolumeReclaimPolicy
+	volume.Spec.PersistentVolumeReclaimPolicy = policy
+	if _, err := c.CoreV1().PersistentVolumes().Update(volume); err != nil {
+		return "", err
+	}
+
+	return previousPolicy, nil
+}
+
+// releasePersistentVolume removes the claim the persistent volume was bound to, so a new claim can bind to it
+func (c *Client) releasePersistentVolume(name string) error {
+	volume, err := c.CoreV1().PersistentVolumes().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	volume.Spec.ClaimRef = nil
+	_, err = c.CoreV1().PersistentVolumes().Update(volume)
+
+	return err
+}
+
+// replaceWorkspaceVolumeClaim replaces the persistent volume claim of a workspace with the claim, which is populated from its data source.
+// The claim is created under another name first and the existing claim is only deleted once the new volume is bound,
+// so a claim that can not be restored keeps the existing volume. The new volume is then retained while it is moved to a claim
+// with the name of the existing one, so it is kept even if that fails.
+func (c *Client) replaceWorkspaceVolumeClaim(namespace string, claim *corev1.PersistentVolumeClaim) error {
+	persistentVolumeClaims := c.CoreV1().PersistentVolumeClaims(namespace)
+
+	restoredClaim := claim.DeepCopy()
+	restoredClaim.Name = restoredWorkspaceVolumeClaimName(claim.Name)
+	if err := c.deleteWorkspaceVolumeClaim(namespace, restoredClaim.Name); err != nil {
+		return err
+	}
+	if _, err := persistentVolumeClaims.Create(restoredClaim); err != nil {
+		return err
+	}
+
+	volumeName, err := c.waitForWorkspaceVolumeClaimBound(namespace, restoredClaim.Name)
+	if err == nil {
+		err = c.deleteWorkspaceVolumeClaim(namespace, claim.Name)
+	}
+	if err != nil {
+		if deleteErr := c.deleteWorkspaceVolumeClaim(namespace, restoredClaim.Name); deleteErr != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Claim":     restoredClaim.Name,
+				"Error":     deleteErr.Error(),
+			}).Error("Unable to delete restored workspace volume.")
+		}
+
+		return err
+	}
+
+	reclaimPolicy, err := c.setPersistentVolumeReclaimPolicy(volumeName, corev1.PersistentVolumeReclaimRetain)
+	if err != nil {
+		return err
+	}
+	if err := c.deleteWorkspaceVolumeClaim(namespace, restoredClaim.Name); err != nil {
+		return err
+	}
+	if err := c.releasePersistentVolume(volumeName); err != nil {
+		return err
+	}
+
+	claim = claim.DeepCopy()
+	claim.Spec.DataSource = nil
+	claim.Spec.VolumeName = volumeName
+	if _, err := persistentVolumeClaims.Create(claim); err != nil {
+		return err
+	}
+
+	if _, err := c.setPersistentVolumeReclaimPolicy(volumeName, reclaimPolicy); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Claim":     claim.Name,
+			"Volume":    volumeName,
+			"Error":     err.Error(),
+		}).Error("Unable to reset the reclaim policy of restored workspace volume.")
+	}
+
+	return nil
+}
+
+// replaceWorkspaceVolumeClaims replaces the persistent volume claims of a workspace, see replaceWorkspaceVolumeClaim
+func (c *Client) replaceWorkspaceVolumeClaims(namespace string, claims []*corev1.PersistentVolumeClaim) error {
+	for _, claim := range claims {
+		if err := c.replaceWorkspaceVolumeClaim(namespace, claim); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createWorkspaceVolumeClaims creates the persistent volume claims of a new workspace
+func (c *Client) createWorkspaceVolumeClaims(namespace string, claims []*corev1.PersistentVolumeClaim) error {
+	for _, claim := range claims {
+		if _, err := c.CoreV1().PersistentVolumeClaims(namespace).Create(claim); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cloneWorkspaceVolumeClaims creates persistent volume claims for the workspace that are populated from the
+// persistent volume claims of the source workspace
+func (c *Client) cloneWorkspaceVolumeClaims(namespace, sourceWorkspaceUID, workspaceUID string) error {
+	sourceClaims, err := c.listWorkspaceVolumeClaims(namespace, sourceWorkspaceUID)
+	if err != nil {
+		return err
+	}
+
+	claims := make([]*corev1.PersistentVolumeClaim, 0)
+	for i := range sourceClaims {
+		sourceClaim := &sourceClaims[i]
+		claimName, _ := workspaceVolumeClaimTemplateName(sourceClaim.Name, sourceWorkspaceUID)
+
+		// A cloned volume can not be smaller than its source
+		storage, ok := workspaceVolumeClaimStorage(sourceClaim)
+		if !ok {
+			continue
+		}
+
+		claims = append(claims, &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: workspaceVolumeClaimName(claimName, workspaceUID),
+				Labels: map[string]string{
+					"app": workspaceUID,
+				},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes:      sourceClaim.Spec.AccessModes,
+				StorageClassName: sourceClaim.Spec.StorageClassName,
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: storage,
+					},
+				},
+				DataSource: &corev1.TypedLocalObjectReference{
+					Kind: "PersistentVolumeClaim",
+					Name: sourceClaim.Name,
+				},
+			},
+		})
+	}
+
+	if err := c.createWorkspaceVolumeClaims(namespace, claims); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":       namespace,
+			"Workspace":       workspaceUID,
+			"SourceWorkspace": sourceWorkspaceUID,
+			"Error":           err.Error(),
+		}).Error("Unable to clone workspace volumes.")
+		return util.NewUserError(codes.Internal, "Unable to clone workspace volumes.")
+	}
+
+	return nil
+}
+
+// deleteWorkspaceVolumeClaims deletes the persistent volume claims of the workspace StatefulSet.
+// It cleans up the volumes restored or cloned for a workspace that could not be created, so errors are logged.
+func (c *Client) deleteWorkspaceVolumeClaims(namespace, workspaceUID string) {
+	claims, err := c.listWorkspaceVolumeClaims(namespace, workspaceUID)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Workspace": workspaceUID,
+			"Error":     err.Error(),
+		}).Error("Unable to list workspace volumes.")
+		return
+	}
+
+	for _, claim := range claims {
+		err := c.CoreV1().PersistentVolumeClaims(namespace).Delete(claim.Name, &metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Workspace": workspaceUID,
+				"Claim":     claim.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to delete workspace volume.")
+		}
+	}
+}
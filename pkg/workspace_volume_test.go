@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func Test_workspaceVolumeClaimTemplateName(t *testing.T) {
+	name, ok := workspaceVolumeClaimTemplateName(workspaceVolumeClaimName("data", "jupyter"), "jupyter")
+	assert.True(t, ok)
+	assert.Equal(t, "data", name)
+
+	_, ok = workspaceVolumeClaimTemplateName("data-jupyter-lab-0", "jupyter")
+	assert.False(t, ok)
+
+	_, ok = workspaceVolumeClaimTemplateName("-jupyter-0", "jupyter")
+	assert.False(t, ok)
+}
+
+// Test_replaceWorkspaceVolumeClaim_CreateFails makes sure the existing claim is kept if the restored one can not be created
+func Test_replaceWorkspaceVolumeClaim_CreateFails(t *testing.T) {
+	claim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workspaceVolumeClaimName("data", "jupyter"),
+			Namespace: "onepanel",
+		},
+	}
+	k8sFake := fake.NewSimpleClientset(claim)
+	k8sFake.PrependReactor("create", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("exceeded quota")
+	})
+	c := &Client{Interface: k8sFake}
+
+	assert.NotNil(t, c.replaceWorkspaceVolumeClaim("onepanel", claim))
+
+	_, err := k8sFake.CoreV1().PersistentVolumeClaims("onepanel").Get(claim.Name, metav1.GetOptions{})
+	assert.Nil(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"net/http"
 	"strings"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	v1 "github.com/onepanelio/core/pkg"
@@ -187,6 +188,9 @@ func verifyLogin(client *v1.Client, tokenRequest *api.GetAccessTokenRequest) (ra
 //   1. Is the token valid? This is used for logging in.
 //   2. Is there a token? There should be a token for everything except logging in.
 func UnaryInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfig) grpc.UnaryServerInterceptor {
+	// Webhook requests have no token, so their clients use the default config, which is loaded once
+	webhookConfig := v1.NewConfig()
+
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		// Check if the provided token is valid. This does not require a token in the header.
 		if info.FullMethod == "/api.AuthService/GetAccessToken" {
@@ -294,6 +298,34 @@ func UnaryInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfi
 			}
 		}
 
+		// Webhooks are authenticated by their signature instead of a token.
+		// The signature is checked before a client is created for the request, so unsigned requests cost nothing.
+		if info.FullMethod == "/api.WorkflowTriggerService/ReceiveWorkflowTriggerWebhook" {
+			webhookRequest, ok := req.(*api.ReceiveWorkflowTriggerWebhookRequest)
+			if !ok {
+				return resp, errors.New("invalid request object for ReceiveWorkflowTriggerWebhookRequest")
+			}
+
+			err := (&v1.WorkflowTriggerWebhookRequest{
+				Namespace: webhookRequest.Namespace,
+				UID:       webhookRequest.Uid,
+				Timestamp: webhookRequest.Timestamp,
+				Payload:   webhookRequest.Payload,
+				Signature: webhookRequest.Signature,
+			}).Verify(sysConfig.HMACKey(), time.Now())
+			if err != nil {
+				return nil, err
+			}
+
+			config := *webhookConfig
+			webhookClient, err := v1.NewClient(&config, db, sysConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			return handler(context.WithValue(ctx, ContextClientKey, webhookClient), req)
+		}
+
 		// This guy checks for the token
 		ctx, err = getClient(ctx, kubeConfig, db, sysConfig)
 		if err != nil {
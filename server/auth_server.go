@@ -8,6 +8,7 @@ import (
 	"github.com/onepanelio/core/pkg/util"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -48,10 +49,54 @@ func (a *AuthServer) IsAuthorized(ctx context.Context, request *api.IsAuthorized
 		return res, util.NewUserError(codes.PermissionDenied, fmt.Sprintf("Namespace: %v, Verb: %v, Group: \"%v\", Resource: %v. Source: %v", request.IsAuthorized.Namespace, request.IsAuthorized.Verb, request.IsAuthorized.Group, request.IsAuthorized.ResourceName, err))
 	}
 
+	// Only users a workspace is shared with may use it, on top of having access to workspaces in the namespace
+	if allowed && request.IsAuthorized.Resource == "workspaces" && request.IsAuthorized.ResourceName != "" {
+		allowed, err = authorizeWorkspaceRequest(client, request.IsAuthorized.Namespace, request.IsAuthorized.ResourceName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Requests to a workspace are checked here by the ingress, so they count as activity in the workspace
+	if allowed && request.IsAuthorized.Resource == "workspaces" && request.IsAuthorized.ResourceName != "" {
+		if err := client.RecordWorkspaceActivity(request.IsAuthorized.Namespace, request.IsAuthorized.ResourceName); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": request.IsAuthorized.Namespace,
+				"UID":       request.IsAuthorized.ResourceName,
+				"Error":     err.Error(),
+			}).Error("Unable to record workspace activity.")
+		}
+	}
+
 	res.Authorized = allowed
 	return res, nil
 }
 
+// authorizeWorkspaceRequest allows a request to a workspace if the workspace is shared with the user.
+// The ingress denies the request otherwise, so access does not depend on headers a client could set.
+// Workspaces that are not in the database, like modeldb, are not shared, so their requests are allowed.
+func authorizeWorkspaceRequest(client *v1.Client, namespace, uid string) (allowed bool, err error) {
+	username, err := client.Username()
+	if err != nil {
+		return false, err
+	}
+
+	role, err := client.GetWorkspaceRole(namespace, uid, username)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	if role == "" {
+		return false, util.NewUserError(codes.PermissionDenied, "Permission denied. The workspace is not shared with you.")
+	}
+
+	return true, nil
+}
+
 // GetAccessToken is an alias for IsValidToken. It returns a token given a username and hashed token.
 func (a *AuthServer) GetAccessToken(ctx context.Context, req *api.GetAccessTokenRequest) (res *api.GetAccessTokenResponse, err error) {
 	if ctx == nil {
@@ -3,6 +3,7 @@ package converter
 import (
 	api "github.com/onepanelio/core/api/gen"
 	v1 "github.com/onepanelio/core/pkg"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"sort"
 	"time"
 )
@@ -73,6 +74,58 @@ func APIMetricsToCore(metrics []*api.Metric) v1.Metrics {
 	return result
 }
 
+// APIMetricPointsToCore converts []*api.MetricPoint to []*v1.MetricPoint
+// Timestamps are expected in RFC3339 format, points without one are left with a zero time.
+func APIMetricPointsToCore(points []*api.MetricPoint) ([]*v1.MetricPoint, error) {
+	result := make([]*v1.MetricPoint, len(points))
+
+	for i, point := range points {
+		result[i] = &v1.MetricPoint{
+			Name:   point.Name,
+			Step:   point.Step,
+			Value:  point.Value,
+			Format: point.Format,
+		}
+
+		if point.Timestamp != "" {
+			createdAt, err := time.Parse(time.RFC3339, point.Timestamp)
+			if err != nil {
+				return nil, err
+			}
+			result[i].CreatedAt = createdAt
+		}
+	}
+
+	return result, nil
+}
+
+// MetricSeriesToAPI converts []*v1.MetricSeries to []*api.MetricSeries
+func MetricSeriesToAPI(series []*v1.MetricSeries) []*api.MetricSeries {
+	result := make([]*api.MetricSeries, len(series))
+
+	for i, s := range series {
+		apiSeries := &api.MetricSeries{
+			Name:   s.Name,
+			Format: s.Format,
+			Points: make([]*api.MetricPoint, len(s.Points)),
+		}
+
+		for j, point := range s.Points {
+			apiSeries.Points[j] = &api.MetricPoint{
+				Name:      point.Name,
+				Step:      point.Step,
+				Value:     point.Value,
+				Format:    point.Format,
+				Timestamp: point.CreatedAt.Format(time.RFC3339),
+			}
+		}
+
+		result[i] = apiSeries
+	}
+
+	return result
+}
+
 // LabelsToKeyValues converts []*v1.Label to []*api.Label
 func LabelsToKeyValues(labels []*v1.Label) []*api.KeyValue {
 	keyValues := make([]*api.KeyValue, 0)
@@ -158,6 +211,15 @@ func ParameterToAPI(param v1.Parameter) *api.Parameter {
 	if param.Options != nil {
 		apiParam.Options = ParameterOptionsToAPI(param.Options)
 	}
+	if param.Min != nil {
+		apiParam.Min = wrapperspb.Double(*param.Min)
+	}
+	if param.Max != nil {
+		apiParam.Max = wrapperspb.Double(*param.Max)
+	}
+	if param.Pattern != nil {
+		apiParam.Pattern = *param.Pattern
+	}
 
 	return apiParam
 }
@@ -193,6 +255,15 @@ func APIParameterToInternal(param *api.Parameter) *v1.Parameter {
 	if param.Options != nil {
 		result.Options = APIParameterOptionsToInternal(param.Options)
 	}
+	if param.Min != nil {
+		result.Min = &param.Min.Value
+	}
+	if param.Max != nil {
+		result.Max = &param.Max.Value
+	}
+	if param.Pattern != "" {
+		result.Pattern = &param.Pattern
+	}
 
 	return result
 }
@@ -219,6 +290,7 @@ func WorkflowExecutionStatisticsReportToAPI(report *v1.WorkflowExecutionStatisti
 		Completed:    report.Completed,
 		Failed:       report.Failed,
 		Terminated:   report.Terminated,
+		Suspended:    report.Suspended,
 		LastExecuted: TimestampToAPIString(report.LastExecuted),
 	}
 
@@ -251,3 +323,47 @@ func WorkspaceStatisticsReportToAPI(report *v1.WorkspaceStatisticReport) *api.Wo
 
 	return stats
 }
+
+// TemplateVersionDiffToAPI converts a *v1.TemplateVersionDiff to a *api.TemplateVersionDiff
+func TemplateVersionDiffToAPI(diff *v1.TemplateVersionDiff) *api.TemplateVersionDiff {
+	result := &api.TemplateVersionDiff{
+		FromVersion:      diff.FromVersion,
+		ToVersion:        diff.ToVersion,
+		Changes:          make([]*api.ManifestChange, len(diff.Changes)),
+		ParameterChanges: ParameterChangesToAPI(diff.ParameterChanges),
+	}
+
+	for i, change := range diff.Changes {
+		result.Changes[i] = &api.ManifestChange{
+			Path:     change.Path,
+			Type:     change.Type,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+		}
+	}
+
+	return result
+}
+
+// ParameterChangesToAPI converts []*v1.ParameterChange to []*api.ParameterChange
+func ParameterChangesToAPI(changes []*v1.ParameterChange) []*api.ParameterChange {
+	result := make([]*api.ParameterChange, len(changes))
+	for i, change := range changes {
+		apiChange := &api.ParameterChange{
+			Name:    change.Name,
+			Type:    change.Type,
+			OldType: change.OldType,
+			NewType: change.NewType,
+		}
+		if change.OldValue != nil {
+			apiChange.OldValue = *change.OldValue
+		}
+		if change.NewValue != nil {
+			apiChange.NewValue = *change.NewValue
+		}
+
+		result[i] = apiChange
+	}
+
+	return result
+}
@@ -5,10 +5,21 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	api "github.com/onepanelio/core/api/gen"
 	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
 	"github.com/onepanelio/core/pkg/util/ptr"
 	"github.com/onepanelio/core/pkg/util/request/pagination"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/onepanelio/core/server/converter"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"time"
+)
+
+const (
+	// defaultCronWorkflowNextRuns is the number of next run times returned by GetCronWorkflow if none is requested
+	defaultCronWorkflowNextRuns = 5
+	// maxCronWorkflowNextRuns is the maximum number of next run times returned by GetCronWorkflow
+	maxCronWorkflowNextRuns = 100
 )
 
 // CronWorkflowServer is an implementation of the grpc CronWorkflowServer
@@ -34,6 +45,10 @@ func apiCronWorkflow(cwf *v1.CronWorkflow) (cronWorkflow *api.CronWorkflow) {
 		Namespace: cwf.Namespace,
 	}
 
+	if schedule, err := cwf.GetSchedule(); err == nil {
+		cronWorkflow.Suspended = schedule.Suspend
+	}
+
 	if cwf.WorkflowExecution != nil {
 		cronWorkflow.WorkflowExecution = apiWorkflowExecution(cwf.WorkflowExecution, nil)
 		for _, param := range cwf.WorkflowExecution.Parameters {
@@ -48,6 +63,28 @@ func apiCronWorkflow(cwf *v1.CronWorkflow) (cronWorkflow *api.CronWorkflow) {
 	return
 }
 
+func apiCronWorkflowRun(run *v1.WorkflowExecution) *api.CronWorkflowRun {
+	if run == nil {
+		return nil
+	}
+
+	apiRun := &api.CronWorkflowRun{
+		Uid:       run.UID,
+		Name:      run.Name,
+		Phase:     string(run.Phase),
+		CreatedAt: run.CreatedAt.Format(time.RFC3339),
+		Duration:  int64(run.Duration().Seconds()),
+	}
+	if run.StartedAt != nil && !run.StartedAt.IsZero() {
+		apiRun.StartedAt = run.StartedAt.Format(time.RFC3339)
+	}
+	if run.FinishedAt != nil && !run.FinishedAt.IsZero() {
+		apiRun.FinishedAt = run.FinishedAt.Format(time.RFC3339)
+	}
+
+	return apiRun
+}
+
 func (c *CronWorkflowServer) CreateCronWorkflow(ctx context.Context, req *api.CreateCronWorkflowRequest) (*api.CronWorkflow, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "cronworkflows", "")
@@ -81,6 +118,15 @@ func (c *CronWorkflowServer) CreateCronWorkflow(ctx context.Context, req *api.Cr
 		})
 	}
 
+	workflowTemplate, err := client.GetWorkflowTemplate(req.Namespace, workflow.WorkflowTemplate.UID, workflow.WorkflowTemplate.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v1.ValidateManifestParameters(workflowTemplate.Manifest, workflow.Parameters); err != nil {
+		return nil, err
+	}
+
 	cronWorkflow := v1.CronWorkflow{
 		WorkflowExecution: workflow,
 		Manifest:          req.CronWorkflow.Manifest,
@@ -155,7 +201,74 @@ func (c *CronWorkflowServer) GetCronWorkflow(ctx context.Context, req *api.GetCr
 	if err != nil {
 		return nil, err
 	}
-	return apiCronWorkflow(cwf), nil
+
+	nextRuns := int(req.NextRuns)
+	if nextRuns <= 0 {
+		nextRuns = defaultCronWorkflowNextRuns
+	}
+	if nextRuns > maxCronWorkflowNextRuns {
+		return nil, util.NewUserError(codes.InvalidArgument, "At most 100 next runs can be requested.")
+	}
+
+	// A schedule that can not be parsed has no next runs, but the cron workflow is still returned
+	var nextRunTimes []time.Time
+	schedule, err := cwf.GetSchedule()
+	if err == nil {
+		nextRunTimes, err = schedule.NextRunTimes(time.Now(), nextRuns)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": req.Namespace,
+			"UID":       req.Uid,
+			"Error":     err.Error(),
+		}).Error("Unable to get the next run times of the cron workflow.")
+	}
+
+	summary, err := client.GetCronWorkflowRunSummary(req.Namespace, cwf.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	cronWorkflow := apiCronWorkflow(cwf)
+	for _, nextRunTime := range nextRunTimes {
+		cronWorkflow.NextRunTimes = append(cronWorkflow.NextRunTimes, nextRunTime.Format(time.RFC3339))
+	}
+	cronWorkflow.LastSuccess = apiCronWorkflowRun(summary.LastSuccess)
+	cronWorkflow.LastFailure = apiCronWorkflowRun(summary.LastFailure)
+
+	return cronWorkflow, nil
+}
+
+// ListCronWorkflowRuns returns the workflow executions started by a cron workflow, newest first
+func (c *CronWorkflowServer) ListCronWorkflowRuns(ctx context.Context, req *api.ListCronWorkflowRunsRequest) (*api.ListCronWorkflowRunsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "cronworkflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	runs, err := client.ListCronWorkflowRuns(req.Namespace, req.Uid, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := client.CountCronWorkflowRuns(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListCronWorkflowRunsResponse{
+		Count:      int32(len(runs)),
+		Page:       int32(paginator.Page),
+		Pages:      paginator.CalculatePages(count),
+		TotalCount: int32(count),
+	}
+	for _, run := range runs {
+		res.Runs = append(res.Runs, apiCronWorkflowRun(run))
+	}
+
+	return res, nil
 }
 
 func (c *CronWorkflowServer) ListCronWorkflows(ctx context.Context, req *api.ListCronWorkflowRequest) (*api.ListCronWorkflowsResponse, error) {
@@ -165,14 +278,28 @@ func (c *CronWorkflowServer) ListCronWorkflows(ctx context.Context, req *api.Lis
 		return nil, err
 	}
 
-	paginator := pagination.NewRequest(req.Page, req.PageSize)
-	cronWorkflows, err := client.ListCronWorkflows(req.Namespace, req.WorkflowTemplateName, &paginator)
+	paginator := pagination.NewWithContinue(req.Page, req.PageSize, req.ContinueToken)
+	cronWorkflows, err := client.ListCronWorkflows(req.Namespace, req.WorkflowTemplateName, paginator)
+	if err != nil {
+		return nil, err
+	}
+	cronWorkflowIDs := make([]uint64, 0, len(cronWorkflows))
+	for _, cwf := range cronWorkflows {
+		cronWorkflowIDs = append(cronWorkflowIDs, cwf.ID)
+	}
+	summaries, err := client.ListCronWorkflowRunSummaries(cronWorkflowIDs)
 	if err != nil {
 		return nil, err
 	}
+
 	var apiCronWorkflows []*api.CronWorkflow
 	for _, cwf := range cronWorkflows {
-		apiCronWorkflows = append(apiCronWorkflows, apiCronWorkflow(cwf))
+		cronWorkflow := apiCronWorkflow(cwf)
+		if summary, ok := summaries[cwf.ID]; ok {
+			cronWorkflow.LastSuccess = apiCronWorkflowRun(summary.LastSuccess)
+			cronWorkflow.LastFailure = apiCronWorkflowRun(summary.LastFailure)
+		}
+		apiCronWorkflows = append(apiCronWorkflows, cronWorkflow)
 	}
 
 	count, err := client.CountCronWorkflows(req.Namespace, req.WorkflowTemplateName)
@@ -181,14 +308,156 @@ func (c *CronWorkflowServer) ListCronWorkflows(ctx context.Context, req *api.Lis
 	}
 
 	return &api.ListCronWorkflowsResponse{
-		Count:         int32(len(apiCronWorkflows)),
-		CronWorkflows: apiCronWorkflows,
-		Page:          int32(paginator.Page),
-		Pages:         paginator.CalculatePages(count),
-		TotalCount:    int32(count),
+		Count:             int32(len(apiCronWorkflows)),
+		CronWorkflows:     apiCronWorkflows,
+		Page:              int32(paginator.Page),
+		Pages:             paginator.CalculatePages(count),
+		TotalCount:        int32(count),
+		NextContinueToken: paginator.NextContinue,
 	}, nil
 }
 
+// SuspendCronWorkflow stops a cron workflow from running on its schedule
+func (c *CronWorkflowServer) SuspendCronWorkflow(ctx context.Context, req *api.SuspendCronWorkflowRequest) (*api.CronWorkflow, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "cronworkflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	cwf, err := client.SuspendCronWorkflow(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiCronWorkflow(cwf), nil
+}
+
+// ResumeCronWorkflow runs a suspended cron workflow on its schedule again
+func (c *CronWorkflowServer) ResumeCronWorkflow(ctx context.Context, req *api.ResumeCronWorkflowRequest) (*api.CronWorkflow, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "cronworkflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	cwf, err := client.ResumeCronWorkflow(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiCronWorkflow(cwf), nil
+}
+
+// RunCronWorkflowNow starts a run of a cron workflow outside of its schedule
+func (c *CronWorkflowServer) RunCronWorkflowNow(ctx context.Context, req *api.RunCronWorkflowNowRequest) (*api.WorkflowExecution, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "cronworkflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	we, err := client.RunCronWorkflowNow(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecution(we, nil), nil
+}
+
+func apiCronWorkflowBackfill(backfill *v1.CronWorkflowBackfill) *api.CronWorkflowBackfill {
+	apiBackfill := &api.CronWorkflowBackfill{
+		Uid:           backfill.UID,
+		StartTime:     backfill.StartTime.Format(time.RFC3339),
+		EndTime:       backfill.EndTime.Format(time.RFC3339),
+		Parallelism:   int32(backfill.Parallelism),
+		Phase:         string(backfill.Phase),
+		CreatedAt:     backfill.CreatedAt.UTC().Format(time.RFC3339),
+		Runs:          int32(backfill.Runs),
+		LaunchedRuns:  int32(backfill.LaunchedRuns),
+		SucceededRuns: int32(backfill.SucceededRuns),
+		FailedRuns:    int32(backfill.FailedRuns),
+	}
+	if backfill.FinishedAt != nil {
+		apiBackfill.FinishedAt = backfill.FinishedAt.UTC().Format(time.RFC3339)
+	}
+
+	return apiBackfill
+}
+
+// BackfillCronWorkflow runs a cron workflow for the times it was scheduled to run in a past range
+func (c *CronWorkflowServer) BackfillCronWorkflow(ctx context.Context, req *api.BackfillCronWorkflowRequest) (*api.CronWorkflowBackfill, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "cronworkflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	start, err := time.Parse(time.RFC3339, req.StartTime)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Start time must be in RFC3339 format.")
+	}
+	end, err := time.Parse(time.RFC3339, req.EndTime)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "End time must be in RFC3339 format.")
+	}
+
+	backfill, err := client.BackfillCronWorkflow(req.Namespace, req.Uid, start, end, int(req.Parallelism))
+	if err != nil {
+		return nil, err
+	}
+
+	return apiCronWorkflowBackfill(backfill), nil
+}
+
+// ListCronWorkflowBackfills returns the backfills of a cron workflow, newest first
+func (c *CronWorkflowServer) ListCronWorkflowBackfills(ctx context.Context, req *api.ListCronWorkflowBackfillsRequest) (*api.ListCronWorkflowBackfillsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "cronworkflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	backfills, err := client.ListCronWorkflowBackfills(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListCronWorkflowBackfillsResponse{}
+	for _, backfill := range backfills {
+		res.Backfills = append(res.Backfills, apiCronWorkflowBackfill(backfill))
+	}
+
+	return res, nil
+}
+
+// CancelCronWorkflowBackfill stops a running backfill of a cron workflow
+func (c *CronWorkflowServer) CancelCronWorkflowBackfill(ctx context.Context, req *api.CancelCronWorkflowBackfillRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "cronworkflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.CancelCronWorkflowBackfill(req.Namespace, req.Uid, req.BackfillUid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (c *CronWorkflowServer) DeleteCronWorkflow(ctx context.Context, req *api.DeleteCronWorkflowRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "argoproj.io", "cronworkflows", "")
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
 	"github.com/onepanelio/core/pkg/util"
@@ -12,7 +13,10 @@ import (
 	"github.com/onepanelio/core/pkg/util/router"
 	"github.com/onepanelio/core/server/converter"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"time"
 
@@ -82,6 +86,137 @@ func removedUnusedManifestFields(manifest string) (string, error) {
 	return string(finalManifestBytes), nil
 }
 
+// apiWorkflowExecutionComparison converts a workflow execution comparison to its api representation
+func apiWorkflowExecutionComparison(comparison *v1.WorkflowExecutionComparison) *api.CompareWorkflowExecutionsResponse {
+	result := &api.CompareWorkflowExecutionsResponse{
+		WorkflowExecutions: make([]*api.ComparedWorkflowExecution, len(comparison.WorkflowExecutions)),
+		Parameters:         make([]*api.ParameterComparison, len(comparison.Parameters)),
+		Metrics:            make([]*api.MetricComparison, len(comparison.Metrics)),
+	}
+
+	for i, we := range comparison.WorkflowExecutions {
+		apiWe := &api.ComparedWorkflowExecution{
+			Uid:      we.UID,
+			Name:     we.Name,
+			Phase:    string(we.Phase),
+			Duration: int64(we.Duration().Seconds()),
+		}
+		if we.StartedAt != nil && !we.StartedAt.IsZero() {
+			apiWe.StartedAt = we.StartedAt.Format(time.RFC3339)
+		}
+		if we.FinishedAt != nil && !we.FinishedAt.IsZero() {
+			apiWe.FinishedAt = we.FinishedAt.Format(time.RFC3339)
+		}
+		if we.WorkflowTemplate != nil {
+			apiWe.WorkflowTemplateUid = we.WorkflowTemplate.UID
+			apiWe.WorkflowTemplateVersion = we.WorkflowTemplate.Version
+		}
+
+		result.WorkflowExecutions[i] = apiWe
+	}
+
+	for i, parameter := range comparison.Parameters {
+		apiParameter := &api.ParameterComparison{
+			Name:    parameter.Name,
+			Values:  make([]string, len(parameter.Values)),
+			Present: make([]bool, len(parameter.Values)),
+			Differs: parameter.Differs,
+		}
+		for j, value := range parameter.Values {
+			if value != nil {
+				apiParameter.Values[j] = *value
+				apiParameter.Present[j] = true
+			}
+		}
+
+		result.Parameters[i] = apiParameter
+	}
+
+	for i, metric := range comparison.Metrics {
+		apiMetric := &api.MetricComparison{
+			Name:    metric.Name,
+			Format:  metric.Format,
+			Values:  make([]float64, len(metric.Values)),
+			Present: make([]bool, len(metric.Values)),
+			Differs: metric.Differs,
+			BestUid: metric.BestUID,
+		}
+		for j, value := range metric.Values {
+			if value != nil {
+				apiMetric.Values[j] = *value
+				apiMetric.Present[j] = true
+			}
+		}
+
+		result.Metrics[i] = apiMetric
+	}
+
+	return result
+}
+
+// apiWorkflowExecutionArtifacts converts workflow execution artifacts to their api representation
+func apiWorkflowExecutionArtifacts(artifacts []v1.WorkflowExecutionArtifact) []*api.WorkflowExecutionGraphArtifact {
+	result := make([]*api.WorkflowExecutionGraphArtifact, len(artifacts))
+	for i, artifact := range artifacts {
+		result[i] = &api.WorkflowExecutionGraphArtifact{
+			Name:   artifact.Name,
+			Path:   artifact.Path,
+			Bucket: artifact.Bucket,
+			Key:    artifact.Key,
+		}
+	}
+
+	return result
+}
+
+// apiWorkflowExecutionGraph converts a workflow execution graph to its api representation
+func apiWorkflowExecutionGraph(graph *v1.WorkflowExecutionGraph) *api.WorkflowExecutionGraph {
+	result := &api.WorkflowExecutionGraph{
+		Uid:      graph.UID,
+		Phase:    string(graph.Phase),
+		Duration: int64(graph.Duration.Seconds()),
+		Nodes:    make([]*api.WorkflowExecutionGraphNode, len(graph.Nodes)),
+	}
+
+	if graph.StartedAt != nil {
+		result.StartedAt = graph.StartedAt.Format(time.RFC3339)
+	}
+	if graph.FinishedAt != nil {
+		result.FinishedAt = graph.FinishedAt.Format(time.RFC3339)
+	}
+
+	for i, node := range graph.Nodes {
+		apiNode := &api.WorkflowExecutionGraphNode{
+			Id:              node.ID,
+			Name:            node.Name,
+			DisplayName:     node.DisplayName,
+			Type:            string(node.Type),
+			TemplateName:    node.TemplateName,
+			Phase:           string(node.Phase),
+			Message:         node.Message,
+			Parents:         node.Parents,
+			Children:        node.Children,
+			Duration:        int64(node.Duration.Seconds()),
+			Retries:         int32(node.Retries),
+			Inputs:          converter.ParametersToAPI(node.Inputs),
+			Outputs:         converter.ParametersToAPI(node.Outputs),
+			InputArtifacts:  apiWorkflowExecutionArtifacts(node.InputArtifacts),
+			OutputArtifacts: apiWorkflowExecutionArtifacts(node.OutputArtifacts),
+		}
+
+		if node.StartedAt != nil {
+			apiNode.StartedAt = node.StartedAt.Format(time.RFC3339)
+		}
+		if node.FinishedAt != nil {
+			apiNode.FinishedAt = node.FinishedAt.Format(time.RFC3339)
+		}
+
+		result.Nodes[i] = apiNode
+	}
+
+	return result
+}
+
 // apiWorkflowExecution converts a package workflow execution to the api version
 // router is optional
 func apiWorkflowExecution(wf *v1.WorkflowExecution, router router.Web) (workflow *api.WorkflowExecution) {
@@ -154,6 +289,10 @@ func (s *WorkflowServer) CreateWorkflowExecution(ctx context.Context, req *api.C
 		return nil, err
 	}
 
+	if err := v1.ValidateManifestParameters(workflowTemplate.Manifest, workflow.Parameters); err != nil {
+		return nil, err
+	}
+
 	wf, err := client.CreateWorkflowExecution(req.Namespace, workflow, workflowTemplate)
 	if err != nil {
 		return nil, err
@@ -323,6 +462,87 @@ func (s *WorkflowServer) GetWorkflowExecutionLogs(req *api.GetWorkflowExecutionL
 	return nil
 }
 
+// SearchWorkflowExecutionLogs searches the logs of all the pods of a workflow execution
+func (s *WorkflowServer) SearchWorkflowExecutionLogs(ctx context.Context, req *api.SearchWorkflowExecutionLogsRequest) (*api.SearchWorkflowExecutionLogsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	search := &v1.WorkflowExecutionLogSearch{
+		Pattern:    req.Pattern,
+		Containers: req.Containers,
+		Limit:      int(req.Limit),
+	}
+	if req.Since != "" {
+		since, err := time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Invalid since time.")
+		}
+		search.Since = &since
+	}
+	if req.Until != "" {
+		until, err := time.Parse(time.RFC3339, req.Until)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Invalid until time.")
+		}
+		search.Until = &until
+	}
+
+	matches, err := client.SearchWorkflowExecutionLogs(req.Namespace, req.Uid, search)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.SearchWorkflowExecutionLogsResponse{
+		Matches: make([]*api.WorkflowExecutionLogMatch, len(matches)),
+	}
+	for i, match := range matches {
+		resp.Matches[i] = &api.WorkflowExecutionLogMatch{
+			PodName:       match.PodName,
+			NodeName:      match.NodeName,
+			ContainerName: match.ContainerName,
+			Line:          int32(match.Line),
+			Content:       match.Content,
+		}
+		if !match.Timestamp.IsZero() {
+			resp.Matches[i].Timestamp = match.Timestamp.Format(time.RFC3339Nano)
+		}
+	}
+
+	return resp, nil
+}
+
+// DownloadWorkflowExecutionLogs returns a zip or tar.gz archive of the logs of all the pods of a workflow execution
+func (s *WorkflowServer) DownloadWorkflowExecutionLogs(ctx context.Context, req *api.DownloadWorkflowExecutionLogsRequest) (*httpbody.HttpBody, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	format, err := v1.ParseLogArchiveFormat(req.Format)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	archive, err := client.DownloadWorkflowExecutionLogs(req.Namespace, req.Uid, format)
+	if err != nil {
+		return nil, err
+	}
+
+	disposition := fmt.Sprintf("attachment; filename=\"%v-logs.%v\"", req.Uid, format)
+	if err := grpc.SetHeader(ctx, metadata.Pairs("content-disposition", disposition)); err != nil {
+		return nil, err
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: format.ContentType(),
+		Data:        archive,
+	}, nil
+}
+
 func (s *WorkflowServer) GetWorkflowExecutionMetrics(ctx context.Context, req *api.GetWorkflowExecutionMetricsRequest) (*api.GetWorkflowExecutionMetricsResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -350,6 +570,38 @@ func (s *WorkflowServer) GetWorkflowExecutionMetrics(ctx context.Context, req *a
 	return &api.GetWorkflowExecutionMetricsResponse{Metrics: apiMetrics}, nil
 }
 
+// GetWorkflowExecutionGraph returns the nodes of a workflow execution with their edges, status, timings and artifacts
+func (s *WorkflowServer) GetWorkflowExecutionGraph(ctx context.Context, req *api.GetWorkflowExecutionGraphRequest) (*api.WorkflowExecutionGraph, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	graph, err := client.GetWorkflowExecutionGraph(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecutionGraph(graph), nil
+}
+
+// CompareWorkflowExecutions returns a side by side comparison of the parameters, metrics and status of workflow executions
+func (s *WorkflowServer) CompareWorkflowExecutions(ctx context.Context, req *api.CompareWorkflowExecutionsRequest) (*api.CompareWorkflowExecutionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	comparison, err := client.CompareWorkflowExecutions(req.Namespace, req.Uids, req.MinimizeMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecutionComparison(comparison), nil
+}
+
 // ListWorkflowExecutions returns a list of workflow executions that are specified by the criteria in the ListWorkflowExecutionsRequest
 func (s *WorkflowServer) ListWorkflowExecutions(ctx context.Context, req *api.ListWorkflowExecutionsRequest) (*api.ListWorkflowExecutionsResponse, error) {
 	client := getClient(ctx)
@@ -368,7 +620,7 @@ func (s *WorkflowServer) ListWorkflowExecutions(ctx context.Context, req *api.Li
 	}
 
 	resourceRequest := &request.Request{
-		Pagination: pagination.New(req.Page, req.PageSize),
+		Pagination: pagination.NewWithContinue(req.Page, req.PageSize, req.ContinueToken),
 		Filter: v1.WorkflowExecutionFilter{
 			Labels: labelFilter,
 			Phase:  req.Phase,
@@ -410,6 +662,7 @@ func (s *WorkflowServer) ListWorkflowExecutions(ctx context.Context, req *api.Li
 		Pages:               paginator.CalculatePages(count),
 		TotalCount:          int32(count),
 		TotalAvailableCount: int32(totalCount),
+		NextContinueToken:   paginator.NextContinue,
 	}, nil
 }
 
@@ -434,6 +687,66 @@ func (s *WorkflowServer) ResubmitWorkflowExecution(ctx context.Context, req *api
 	return apiWorkflowExecution(wf, webRouter), nil
 }
 
+// RetryWorkflowExecution retries a failed workflow execution, keeping the steps that succeeded.
+// If a node id is provided, the workflow is restarted from that node.
+func (s *WorkflowServer) RetryWorkflowExecution(ctx context.Context, req *api.RetryWorkflowExecutionRequest) (*api.WorkflowExecution, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	wf, err := client.RetryWorkflowExecution(req.Namespace, req.Uid, req.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	wf.Namespace = req.Namespace
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecution(wf, webRouter), nil
+}
+
+// SuspendWorkflowExecution suspends a running workflow execution
+func (s *WorkflowServer) SuspendWorkflowExecution(ctx context.Context, req *api.SuspendWorkflowExecutionRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.SuspendWorkflowExecution(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// ResumeWorkflowExecution resumes a suspended workflow execution
+func (s *WorkflowServer) ResumeWorkflowExecution(ctx context.Context, req *api.ResumeWorkflowExecutionRequest) (*api.WorkflowExecution, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	wf, err := client.ResumeWorkflowExecution(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	wf.Namespace = req.Namespace
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecution(wf, webRouter), nil
+}
+
 func (s *WorkflowServer) TerminateWorkflowExecution(ctx context.Context, req *api.TerminateWorkflowExecutionRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
@@ -517,6 +830,51 @@ func (s *WorkflowServer) UpdateWorkflowExecutionMetrics(ctx context.Context, req
 	return resp, nil
 }
 
+// AppendWorkflowExecutionMetricPoints adds points to the metrics history of the workflow execution identified by (namespace, uid)
+// and returns the updated metrics summary
+func (s *WorkflowServer) AppendWorkflowExecutionMetricPoints(ctx context.Context, req *api.AppendWorkflowExecutionMetricPointsRequest) (*api.WorkflowExecutionsMetricsResponse, error) {
+	client := getClient(ctx)
+
+	aggregation, err := v1.ParseMetricAggregation(req.Aggregation)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	points, err := converter.APIMetricPointsToCore(req.Points)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Invalid metric point timestamp.")
+	}
+
+	metrics, err := client.AppendWorkflowExecutionMetricPoints(req.Namespace, req.Uid, points, aggregation)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.WorkflowExecutionsMetricsResponse{
+		Metrics: converter.MetricsToAPI(metrics),
+	}
+
+	return resp, nil
+}
+
+// ListWorkflowExecutionMetricSeries returns the metrics history of the workflow execution identified by (namespace, uid)
+func (s *WorkflowServer) ListWorkflowExecutionMetricSeries(ctx context.Context, req *api.ListWorkflowExecutionMetricSeriesRequest) (*api.ListWorkflowExecutionMetricSeriesResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	series, err := client.ListWorkflowExecutionMetricSeries(req.Namespace, req.Uid, req.Names, int(req.MaxPoints))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListWorkflowExecutionMetricSeriesResponse{
+		Series: converter.MetricSeriesToAPI(series),
+	}, nil
+}
+
 // ListWorkflowExecutionsField returns a list of all the distinct values of a field from WorkflowExecutions
 func (s *WorkflowServer) ListWorkflowExecutionsField(ctx context.Context, req *api.ListWorkflowExecutionsFieldRequest) (*api.ListWorkflowExecutionsFieldResponse, error) {
 	client := getClient(ctx)
@@ -6,10 +6,12 @@ import (
 
 	api "github.com/onepanelio/core/api/gen"
 	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
 	"github.com/onepanelio/core/pkg/util/request"
 	"github.com/onepanelio/core/pkg/util/request/pagination"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
 )
 
 // WorkflowTemplateServer is an implementation of the grpc WorkflowTemplateServer
@@ -221,7 +223,7 @@ func (s *WorkflowTemplateServer) ListWorkflowTemplates(ctx context.Context, req
 	}
 
 	resourceRequest := &request.Request{
-		Pagination: pagination.New(req.Page, req.PageSize),
+		Pagination: pagination.NewWithContinue(req.Page, req.PageSize, req.ContinueToken),
 		Filter: v1.WorkflowTemplateFilter{
 			Labels: labelFilter,
 		},
@@ -255,6 +257,7 @@ func (s *WorkflowTemplateServer) ListWorkflowTemplates(ctx context.Context, req
 		Pages:               paginator.CalculatePages(count),
 		TotalCount:          int32(count),
 		TotalAvailableCount: int32(totalCount),
+		NextContinueToken:   paginator.NextContinue,
 	}, nil
 }
 
@@ -294,3 +297,114 @@ func (s *WorkflowTemplateServer) ListWorkflowTemplatesField(ctx context.Context,
 		Values: values,
 	}, nil
 }
+
+// SetLatestWorkflowTemplateVersion makes a previous version of a workflow template the latest one
+func (s *WorkflowTemplateServer) SetLatestWorkflowTemplateVersion(ctx context.Context, req *api.SetLatestWorkflowTemplateVersionRequest) (*api.WorkflowTemplate, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflowtemplates", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	workflowTemplate, err := client.SetLatestWorkflowTemplateVersion(req.Namespace, req.Uid, req.Version, req.Clone)
+	if err != nil {
+		return nil, err
+	}
+
+	versionsCount, err := client.CountWorkflowTemplateVersions(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	workflowTemplate.Versions = int64(versionsCount)
+
+	return apiWorkflowTemplate(workflowTemplate), nil
+}
+
+// DiffWorkflowTemplateVersions returns the changes between two versions of a workflow template
+func (s *WorkflowTemplateServer) DiffWorkflowTemplateVersions(ctx context.Context, req *api.DiffWorkflowTemplateVersionsRequest) (*api.TemplateVersionDiff, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflowtemplates", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	diff, err := client.DiffWorkflowTemplateVersions(req.Namespace, req.Uid, req.FromVersion, req.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.TemplateVersionDiffToAPI(diff), nil
+}
+
+// ExportWorkflowTemplates returns a yaml bundle with every version of the requested workflow templates
+func (s *WorkflowTemplateServer) ExportWorkflowTemplates(ctx context.Context, req *api.ExportWorkflowTemplatesRequest) (*api.ExportWorkflowTemplatesResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflowtemplates", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.IncludeCronWorkflows {
+		allowed, err = auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "cronworkflows", "")
+		if err != nil || !allowed {
+			return nil, err
+		}
+	}
+
+	bundle, err := client.ExportWorkflowTemplates(req.Namespace, req.Uids, req.IncludeCronWorkflows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ExportWorkflowTemplatesResponse{
+		Bundle: string(bundle),
+	}, nil
+}
+
+// ImportWorkflowTemplates creates the workflow templates of a bundle made by ExportWorkflowTemplates
+func (s *WorkflowTemplateServer) ImportWorkflowTemplates(ctx context.Context, req *api.ImportWorkflowTemplatesRequest) (*api.ImportWorkflowTemplatesResponse, error) {
+	policy, err := v1.ParseWorkflowTemplateImportPolicy(req.Policy)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflowtemplates", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if policy == v1.WorkflowTemplateImportPolicyOverwrite {
+		allowed, err = auth.IsAuthorized(client, req.Namespace, "delete", "argoproj.io", "cronworkflows", "")
+		if err != nil || !allowed {
+			return nil, err
+		}
+	}
+
+	if req.IncludeCronWorkflows {
+		allowed, err = auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "cronworkflows", "")
+		if err != nil || !allowed {
+			return nil, err
+		}
+	}
+
+	results, err := client.ImportWorkflowTemplates(req.Namespace, []byte(req.Bundle), policy, req.IncludeCronWorkflows)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResults := make([]*api.WorkflowTemplateImportResult, len(results))
+	for i, result := range results {
+		apiResults[i] = &api.WorkflowTemplateImportResult{
+			Name:          result.Name,
+			Uid:           result.UID,
+			Action:        result.Action,
+			Versions:      int32(result.Versions),
+			CronWorkflows: int32(result.CronWorkflows),
+		}
+	}
+
+	return &api.ImportWorkflowTemplatesResponse{
+		Results: apiResults,
+	}, nil
+}
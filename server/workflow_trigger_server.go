@@ -0,0 +1,165 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/onepanelio/core/server/auth"
+)
+
+// WorkflowTriggerServer is an implementation of the grpc WorkflowTriggerServer
+type WorkflowTriggerServer struct {
+	api.UnimplementedWorkflowTriggerServiceServer
+}
+
+// NewWorkflowTriggerServer creates a new WorkflowTriggerServer
+func NewWorkflowTriggerServer() *WorkflowTriggerServer {
+	return &WorkflowTriggerServer{}
+}
+
+func apiWorkflowTrigger(trigger *v1.WorkflowTrigger) *api.WorkflowTrigger {
+	apiTrigger := &api.WorkflowTrigger{
+		Uid:                     trigger.UID,
+		Name:                    trigger.Name,
+		Type:                    string(trigger.Type),
+		WorkflowTemplateUid:     trigger.WorkflowTemplateUID,
+		WorkflowTemplateVersion: trigger.WorkflowTemplateVersion,
+		Prefix:                  trigger.Prefix,
+		Pattern:                 trigger.Pattern,
+		CreatedAt:               trigger.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	for _, parameter := range trigger.Parameters {
+		apiParameter := &api.WorkflowTriggerParameter{
+			Name: parameter.Name,
+			Path: parameter.Path,
+		}
+		if parameter.Value != nil {
+			apiParameter.Value = *parameter.Value
+		}
+		apiTrigger.Parameters = append(apiTrigger.Parameters, apiParameter)
+	}
+
+	if trigger.LastTriggeredAt != nil {
+		apiTrigger.LastTriggeredAt = trigger.LastTriggeredAt.UTC().Format(time.RFC3339)
+	}
+
+	return apiTrigger
+}
+
+// CreateWorkflowTrigger creates a trigger that starts workflows from a version of a workflow template
+func (s *WorkflowTriggerServer) CreateWorkflowTrigger(ctx context.Context, req *api.CreateWorkflowTriggerRequest) (*api.WorkflowTrigger, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	trigger := &v1.WorkflowTrigger{
+		Name:                    req.WorkflowTrigger.Name,
+		Type:                    v1.WorkflowTriggerType(req.WorkflowTrigger.Type),
+		WorkflowTemplateUID:     req.WorkflowTrigger.WorkflowTemplateUid,
+		WorkflowTemplateVersion: req.WorkflowTrigger.WorkflowTemplateVersion,
+		Prefix:                  req.WorkflowTrigger.Prefix,
+		Pattern:                 req.WorkflowTrigger.Pattern,
+	}
+	for _, parameter := range req.WorkflowTrigger.Parameters {
+		triggerParameter := &v1.WorkflowTriggerParameter{
+			Name: parameter.Name,
+			Path: parameter.Path,
+		}
+		if parameter.Value != "" {
+			triggerParameter.Value = ptr.String(parameter.Value)
+		}
+		trigger.Parameters = append(trigger.Parameters, triggerParameter)
+	}
+
+	trigger, err = client.CreateWorkflowTrigger(req.Namespace, trigger)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowTrigger(trigger), nil
+}
+
+// GetWorkflowTrigger returns a trigger of the namespace
+func (s *WorkflowTriggerServer) GetWorkflowTrigger(ctx context.Context, req *api.GetWorkflowTriggerRequest) (*api.WorkflowTrigger, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	trigger, err := client.GetWorkflowTrigger(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowTrigger(trigger), nil
+}
+
+// ListWorkflowTriggers returns the triggers of the namespace
+func (s *WorkflowTriggerServer) ListWorkflowTriggers(ctx context.Context, req *api.ListWorkflowTriggersRequest) (*api.ListWorkflowTriggersResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	triggers, err := client.ListWorkflowTriggers(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.ListWorkflowTriggersResponse{
+		Count: int32(len(triggers)),
+	}
+	for _, trigger := range triggers {
+		resp.WorkflowTriggers = append(resp.WorkflowTriggers, apiWorkflowTrigger(trigger))
+	}
+
+	return resp, nil
+}
+
+// DeleteWorkflowTrigger deletes a trigger of the namespace
+func (s *WorkflowTriggerServer) DeleteWorkflowTrigger(ctx context.Context, req *api.DeleteWorkflowTriggerRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.DeleteWorkflowTrigger(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// apiWorkflowTriggerWebhookRequest converts a request to the webhook of a trigger
+func apiWorkflowTriggerWebhookRequest(req *api.ReceiveWorkflowTriggerWebhookRequest) *v1.WorkflowTriggerWebhookRequest {
+	return &v1.WorkflowTriggerWebhookRequest{
+		Namespace: req.Namespace,
+		UID:       req.Uid,
+		Timestamp: req.Timestamp,
+		Payload:   req.Payload,
+		Signature: req.Signature,
+	}
+}
+
+// ReceiveWorkflowTriggerWebhook starts a workflow for a request to the webhook of a trigger.
+// The request is authenticated by its signature, so the client is the default one.
+func (s *WorkflowTriggerServer) ReceiveWorkflowTriggerWebhook(ctx context.Context, req *api.ReceiveWorkflowTriggerWebhookRequest) (*api.WorkflowExecution, error) {
+	client := getClient(ctx)
+
+	we, err := client.ReceiveWorkflowTriggerWebhook(apiWorkflowTriggerWebhookRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecution(we, nil), nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/onepanelio/core/server/converter"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"time"
 )
 
@@ -31,6 +32,21 @@ func NewWorkspaceServer() *WorkspaceServer {
 	return &WorkspaceServer{}
 }
 
+// isWorkspaceAuthorized checks that the user may perform the verb on workspaces and that the workspace is shared
+// with them as at least the role
+func isWorkspaceAuthorized(client *v1.Client, namespace, verb, uid string, role v1.WorkspaceRole) (bool, error) {
+	allowed, err := auth.IsAuthorized(client, namespace, verb, "onepanel.io", "workspaces", uid)
+	if err != nil || !allowed {
+		return allowed, err
+	}
+
+	if err := client.AuthorizeWorkspace(namespace, uid, role); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func apiWorkspace(wt *v1.Workspace, config v1.SystemConfig) *api.Workspace {
 	if wt == nil {
 		return nil
@@ -114,6 +130,22 @@ func apiWorkspace(wt *v1.Workspace, config v1.SystemConfig) *api.Workspace {
 		res.Status.TerminatedAt = wt.Status.TerminatedAt.UTC().Format(time.RFC3339)
 	}
 
+	if wt.Status.PausedReason != nil {
+		res.Status.PausedReason = *wt.Status.PausedReason
+	}
+
+	if wt.Status.LastActivityAt != nil {
+		res.Status.LastActivityAt = wt.Status.LastActivityAt.UTC().Format(time.RFC3339)
+	}
+
+	if wt.Owner != nil {
+		res.Owner = *wt.Owner
+	}
+
+	if wt.IdleTimeoutMinutes != nil {
+		res.IdleTimeoutMinutes = wrapperspb.Int32(*wt.IdleTimeoutMinutes)
+	}
+
 	if len(wt.Labels) > 0 {
 		res.Labels = converter.MappingToKeyValue(wt.Labels)
 	}
@@ -125,6 +157,56 @@ func apiWorkspace(wt *v1.Workspace, config v1.SystemConfig) *api.Workspace {
 	return res
 }
 
+func apiWorkspaceSchedule(schedule *v1.WorkspaceSchedule) *api.WorkspaceSchedule {
+	res := &api.WorkspaceSchedule{
+		Id:        schedule.ID,
+		Action:    string(schedule.Action),
+		Schedule:  schedule.Schedule,
+		Timezone:  schedule.Timezone,
+		CreatedAt: schedule.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	if schedule.LastRunAt != nil {
+		res.LastRunAt = schedule.LastRunAt.UTC().Format(time.RFC3339)
+	}
+
+	if nextRunAt, err := schedule.NextRunAt(time.Now()); err == nil {
+		res.NextRunAt = nextRunAt.Format(time.RFC3339)
+	}
+
+	return res
+}
+
+func apiWorkspaceSchedules(schedules []*v1.WorkspaceSchedule) []*api.WorkspaceSchedule {
+	res := make([]*api.WorkspaceSchedule, len(schedules))
+	for i, schedule := range schedules {
+		res[i] = apiWorkspaceSchedule(schedule)
+	}
+
+	return res
+}
+
+func apiWorkspaceSnapshot(snapshot *v1.WorkspaceSnapshot) *api.WorkspaceSnapshot {
+	res := &api.WorkspaceSnapshot{
+		Uid:          snapshot.UID,
+		Name:         snapshot.Name,
+		WorkspaceUid: snapshot.WorkspaceUID,
+		ReadyToUse:   snapshot.IsReady(),
+		CreatedAt:    snapshot.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	for _, volume := range snapshot.Volumes {
+		res.Volumes = append(res.Volumes, &api.WorkspaceSnapshotVolume{
+			ClaimName:    volume.ClaimName,
+			SnapshotName: volume.SnapshotName,
+			Storage:      volume.Storage,
+			ReadyToUse:   volume.ReadyToUse,
+		})
+	}
+
+	return res
+}
+
 // CreateWorkspace create a workspace
 func (s *WorkspaceServer) CreateWorkspace(ctx context.Context, req *api.CreateWorkspaceRequest) (*api.Workspace, error) {
 	client := getClient(ctx)
@@ -140,6 +222,11 @@ func (s *WorkspaceServer) CreateWorkspace(ctx context.Context, req *api.CreateWo
 		},
 		Labels:      converter.APIKeyValueToLabel(req.Body.Labels),
 		CaptureNode: req.Body.CaptureNode,
+		SnapshotUID: req.Body.SnapshotUid,
+	}
+
+	if req.Body.IdleTimeoutMinutes != nil {
+		workspace.IdleTimeoutMinutes = ptr.Int32(req.Body.IdleTimeoutMinutes.Value)
 	}
 
 	for _, param := range req.Body.Parameters {
@@ -161,6 +248,15 @@ func (s *WorkspaceServer) CreateWorkspace(ctx context.Context, req *api.CreateWo
 		return nil, util.NewUserError(codes.AlreadyExists, "That name is reserved, choose a different name for the workspace.")
 	}
 
+	workspaceTemplate, err := client.GetWorkspaceTemplate(req.Namespace, req.Body.WorkspaceTemplateUid, req.Body.WorkspaceTemplateVersion)
+	if err != nil || workspaceTemplate == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace template not found.")
+	}
+
+	if err := v1.ValidateManifestParameters(workspaceTemplate.Manifest, workspace.Parameters); err != nil {
+		return nil, err
+	}
+
 	workspace, err = client.CreateWorkspace(req.Namespace, workspace)
 	if err != nil {
 		return nil, err
@@ -176,10 +272,40 @@ func (s *WorkspaceServer) CreateWorkspace(ctx context.Context, req *api.CreateWo
 	return apiWorkspace, nil
 }
 
+// CloneWorkspace creates a workspace from another workspace, optionally with a copy of its volumes
+func (s *WorkspaceServer) CloneWorkspace(ctx context.Context, req *api.CloneWorkspaceRequest) (*api.Workspace, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "get", req.Uid, v1.WorkspaceRoleViewer)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	allowed, err = auth.IsAuthorized(client, req.Namespace, "create", "onepanel.io", "workspaces", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if _, isReserved := reservedWorkspaceNames[req.Name]; isReserved {
+		return nil, util.NewUserError(codes.AlreadyExists, "That name is reserved, choose a different name for the workspace.")
+	}
+
+	workspace, err := client.CloneWorkspace(req.Namespace, req.Uid, req.Name, req.CopyVolumes)
+	if err != nil {
+		return nil, err
+	}
+
+	sysConfig, err := client.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkspace(workspace, sysConfig), nil
+}
+
 // GetWorkspace returns Workspace information
 func (s *WorkspaceServer) GetWorkspace(ctx context.Context, req *api.GetWorkspaceRequest) (*api.Workspace, error) {
 	client := getClient(ctx)
-	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "get", req.Uid, v1.WorkspaceRoleViewer)
 	if err != nil || !allowed {
 		return nil, err
 	}
@@ -213,6 +339,26 @@ func (s *WorkspaceServer) GetWorkspace(ctx context.Context, req *api.GetWorkspac
 
 	apiWorkspace.TemplateParameters = converter.ParametersToAPI(templateParameters)
 
+	schedules, err := client.ListWorkspaceSchedules(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	apiWorkspace.Schedules = apiWorkspaceSchedules(schedules)
+
+	serviceHealth, err := client.GetWorkspaceServiceHealth(req.Namespace, workspace)
+	if err != nil {
+		return nil, err
+	}
+	for _, health := range serviceHealth {
+		apiWorkspace.ServiceHealth = append(apiWorkspace.ServiceHealth, &api.WorkspaceServiceHealth{
+			Name:    health.Service.Name,
+			Path:    health.Service.Path,
+			Port:    health.Service.Port,
+			Ready:   health.Ready,
+			Message: health.Message,
+		})
+	}
+
 	return apiWorkspace, nil
 }
 
@@ -236,7 +382,7 @@ func (s *WorkspaceServer) UpdateWorkspaceStatus(ctx context.Context, req *api.Up
 // UpdateWorkspace updates a workspace's status
 func (s *WorkspaceServer) UpdateWorkspace(ctx context.Context, req *api.UpdateWorkspaceRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
-	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
 	if err != nil || !allowed {
 		return &empty.Empty{}, err
 	}
@@ -275,7 +421,7 @@ func (s *WorkspaceServer) ListWorkspaces(ctx context.Context, req *api.ListWorks
 	}
 
 	resourceRequest := &request.Request{
-		Pagination: pagination.New(req.Page, req.PageSize),
+		Pagination: pagination.NewWithContinue(req.Page, req.PageSize, req.ContinueToken),
 		Filter: v1.WorkspaceFilter{
 			Labels: labelFilter,
 			Phase:  req.Phase,
@@ -315,13 +461,14 @@ func (s *WorkspaceServer) ListWorkspaces(ctx context.Context, req *api.ListWorks
 		Pages:               paginator.CalculatePages(count),
 		TotalCount:          int32(count),
 		TotalAvailableCount: int32(totalCount),
+		NextContinueToken:   paginator.NextContinue,
 	}, nil
 }
 
 // PauseWorkspace requests to pause a given workspace
 func (s *WorkspaceServer) PauseWorkspace(ctx context.Context, req *api.PauseWorkspaceRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
-	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
 	if err != nil || !allowed {
 		return &empty.Empty{}, err
 	}
@@ -334,7 +481,7 @@ func (s *WorkspaceServer) PauseWorkspace(ctx context.Context, req *api.PauseWork
 // ResumeWorkspace attempts to resume a workspace
 func (s *WorkspaceServer) ResumeWorkspace(ctx context.Context, req *api.ResumeWorkspaceRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
-	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
 	if err != nil || !allowed {
 		return &empty.Empty{}, err
 	}
@@ -354,7 +501,7 @@ func (s *WorkspaceServer) ResumeWorkspace(ctx context.Context, req *api.ResumeWo
 // DeleteWorkspace requests to delete a workspace
 func (s *WorkspaceServer) DeleteWorkspace(ctx context.Context, req *api.DeleteWorkspaceRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
-	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "onepanel.io", "workspaces", req.Uid)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "delete", req.Uid, v1.WorkspaceRoleOwner)
 	if err != nil || !allowed {
 		return &empty.Empty{}, err
 	}
@@ -364,6 +511,104 @@ func (s *WorkspaceServer) DeleteWorkspace(ctx context.Context, req *api.DeleteWo
 	return &empty.Empty{}, err
 }
 
+// bulkWorkspaceFilter returns the filter for the workspaces of a bulk action
+func bulkWorkspaceFilter(req *api.BulkWorkspaceActionRequest) (*v1.WorkspaceFilter, error) {
+	labelFilter, err := v1.LabelsFromString(req.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.WorkspaceFilter{
+		Labels:      labelFilter,
+		Phase:       req.Phase,
+		TemplateUID: req.TemplateUid,
+	}, nil
+}
+
+func apiBulkWorkspaceActionResponse(results []*v1.WorkspaceBulkResult) *api.BulkWorkspaceActionResponse {
+	response := &api.BulkWorkspaceActionResponse{
+		Count: int32(len(results)),
+	}
+
+	for _, result := range results {
+		apiResult := &api.BulkWorkspaceActionResult{
+			Uid:     result.UID,
+			Success: result.Error == nil,
+		}
+		if result.Error != nil {
+			apiResult.Error = result.Error.Error()
+			response.FailedCount++
+		}
+
+		response.Results = append(response.Results, apiResult)
+	}
+
+	return response
+}
+
+// PauseWorkspaces requests to pause the workspaces that match the request
+func (s *WorkspaceServer) PauseWorkspaces(ctx context.Context, req *api.BulkWorkspaceActionRequest) (*api.BulkWorkspaceActionResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	filter, err := bulkWorkspaceFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := client.PauseWorkspaces(req.Namespace, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBulkWorkspaceActionResponse(results), nil
+}
+
+// ResumeWorkspaces requests to resume the paused workspaces that match the request
+func (s *WorkspaceServer) ResumeWorkspaces(ctx context.Context, req *api.BulkWorkspaceActionRequest) (*api.BulkWorkspaceActionResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	filter, err := bulkWorkspaceFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := client.ResumeWorkspaces(req.Namespace, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBulkWorkspaceActionResponse(results), nil
+}
+
+// DeleteWorkspaces requests to delete the workspaces that match the request
+func (s *WorkspaceServer) DeleteWorkspaces(ctx context.Context, req *api.BulkWorkspaceActionRequest) (*api.BulkWorkspaceActionResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "onepanel.io", "workspaces", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	filter, err := bulkWorkspaceFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := client.DeleteWorkspaces(req.Namespace, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiBulkWorkspaceActionResponse(results), nil
+}
+
 // RetryLastWorkspaceAction will attempt the last action on the workspace again.
 func (s *WorkspaceServer) RetryLastWorkspaceAction(ctx context.Context, req *api.RetryActionWorkspaceRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
@@ -392,7 +637,12 @@ func (s *WorkspaceServer) RetryLastWorkspaceAction(ctx context.Context, req *api
 		return nil, util.NewUserError(codes.InvalidArgument, "Workspace is not in a failed state")
 	}
 
-	allowed, err := auth.IsAuthorized(client, req.Namespace, verb, "onepanel.io", "workspaces", req.Uid)
+	role := v1.WorkspaceRoleEditor
+	if verb == "delete" {
+		role = v1.WorkspaceRoleOwner
+	}
+
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, verb, req.Uid, role)
 	if err != nil || !allowed {
 		return &empty.Empty{}, err
 	}
@@ -444,10 +694,63 @@ func (s *WorkspaceServer) GetWorkspaceStatisticsForNamespace(ctx context.Context
 	}, nil
 }
 
+func apiWorkspaceUsages(usages []*v1.WorkspaceUsage) []*api.WorkspaceUsage {
+	result := make([]*api.WorkspaceUsage, 0)
+	for _, usage := range usages {
+		result = append(result, &api.WorkspaceUsage{
+			Key:          usage.Key,
+			Name:         usage.Name,
+			RunningHours: usage.RunningHours,
+		})
+	}
+
+	return result
+}
+
+// GetWorkspaceUsageReport returns how long the workspaces of a namespace ran, per workspace, node pool and label value.
+// Without a start and end, the report is for the last 30 days.
+func (s *WorkspaceServer) GetWorkspaceUsageReport(ctx context.Context, req *api.GetWorkspaceUsageReportRequest) (*api.GetWorkspaceUsageReportResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "onepanel.io", "workspaces", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	end := time.Now().UTC()
+	if req.End != "" {
+		end, err = time.Parse(time.RFC3339, req.End)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "End must be a RFC3339 time.")
+		}
+	}
+
+	start := end.AddDate(0, 0, -30)
+	if req.Start != "" {
+		start, err = time.Parse(time.RFC3339, req.Start)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Start must be a RFC3339 time.")
+		}
+	}
+
+	report, err := client.GetWorkspaceUsageReport(req.Namespace, start.UTC(), end.UTC(), req.LabelKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetWorkspaceUsageReportResponse{
+		Start:      report.Start.Format(time.RFC3339),
+		End:        report.End.Format(time.RFC3339),
+		LabelKey:   report.LabelKey,
+		Workspaces: apiWorkspaceUsages(report.Workspaces),
+		NodePools:  apiWorkspaceUsages(report.NodePools),
+		Labels:     apiWorkspaceUsages(report.Labels),
+	}, nil
+}
+
 // GetWorkspaceContainerLogs returns logs for a given container name in a Workspace
 func (s *WorkspaceServer) GetWorkspaceContainerLogs(req *api.GetWorkspaceContainerLogsRequest, stream api.WorkspaceService_GetWorkspaceContainerLogsServer) error {
 	client := getClient(stream.Context())
-	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "get", req.Uid, v1.WorkspaceRoleViewer)
 	if err != nil || !allowed {
 		return err
 	}
@@ -486,6 +789,214 @@ func (s *WorkspaceServer) GetWorkspaceContainerLogs(req *api.GetWorkspaceContain
 	return nil
 }
 
+// RecordWorkspaceActivity marks a workspace as active so it is not paused for being idle.
+// Workspaces can call this periodically while they are in use.
+func (s *WorkspaceServer) RecordWorkspaceActivity(ctx context.Context, req *api.RecordWorkspaceActivityRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "get", req.Uid, v1.WorkspaceRoleViewer)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.RecordWorkspaceActivity(req.Namespace, req.Uid)
+
+	return &empty.Empty{}, err
+}
+
+// UpdateWorkspaceIdleTimeout sets how long a workspace may be idle before it is paused.
+// Without a timeout, the default of the workspace template is used.
+func (s *WorkspaceServer) UpdateWorkspaceIdleTimeout(ctx context.Context, req *api.UpdateWorkspaceIdleTimeoutRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	var minutes *int32
+	if req.IdleTimeoutMinutes != nil {
+		minutes = ptr.Int32(req.IdleTimeoutMinutes.Value)
+	}
+
+	err = client.UpdateWorkspaceIdleTimeout(req.Namespace, req.Uid, minutes)
+
+	return &empty.Empty{}, err
+}
+
+// ListWorkspaceSchedules returns the schedules that resume and pause a workspace
+func (s *WorkspaceServer) ListWorkspaceSchedules(ctx context.Context, req *api.ListWorkspaceSchedulesRequest) (*api.ListWorkspaceSchedulesResponse, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "get", req.Uid, v1.WorkspaceRoleViewer)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	schedules, err := client.ListWorkspaceSchedules(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListWorkspaceSchedulesResponse{
+		Schedules: apiWorkspaceSchedules(schedules),
+	}, nil
+}
+
+// CreateWorkspaceSchedule adds a schedule that resumes or pauses a workspace
+func (s *WorkspaceServer) CreateWorkspaceSchedule(ctx context.Context, req *api.CreateWorkspaceScheduleRequest) (*api.WorkspaceSchedule, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Schedule == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Schedule is required.")
+	}
+
+	schedule, err := client.CreateWorkspaceSchedule(req.Namespace, req.Uid, &v1.WorkspaceSchedule{
+		Action:   v1.WorkspaceScheduleAction(req.Schedule.Action),
+		Schedule: req.Schedule.Schedule,
+		Timezone: req.Schedule.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkspaceSchedule(schedule), nil
+}
+
+// UpdateWorkspaceSchedule changes a schedule of a workspace
+func (s *WorkspaceServer) UpdateWorkspaceSchedule(ctx context.Context, req *api.UpdateWorkspaceScheduleRequest) (*api.WorkspaceSchedule, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Schedule == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Schedule is required.")
+	}
+
+	schedule, err := client.UpdateWorkspaceSchedule(req.Namespace, req.Uid, &v1.WorkspaceSchedule{
+		ID:       req.Id,
+		Action:   v1.WorkspaceScheduleAction(req.Schedule.Action),
+		Schedule: req.Schedule.Schedule,
+		Timezone: req.Schedule.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkspaceSchedule(schedule), nil
+}
+
+// DeleteWorkspaceSchedule removes a schedule from a workspace
+func (s *WorkspaceServer) DeleteWorkspaceSchedule(ctx context.Context, req *api.DeleteWorkspaceScheduleRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.DeleteWorkspaceSchedule(req.Namespace, req.Uid, req.Id)
+
+	return &empty.Empty{}, err
+}
+
+// CreateWorkspaceSnapshot snapshots the volumes of a workspace
+func (s *WorkspaceServer) CreateWorkspaceSnapshot(ctx context.Context, req *api.CreateWorkspaceSnapshotRequest) (*api.WorkspaceSnapshot, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	snapshot, err := client.CreateWorkspaceSnapshot(req.Namespace, req.Uid, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkspaceSnapshot(snapshot), nil
+}
+
+// ListWorkspaceSnapshots returns the snapshots of a workspace
+func (s *WorkspaceServer) ListWorkspaceSnapshots(ctx context.Context, req *api.ListWorkspaceSnapshotsRequest) (*api.ListWorkspaceSnapshotsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "get", req.Uid, v1.WorkspaceRoleViewer)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	snapshots, err := client.ListWorkspaceSnapshots(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListWorkspaceSnapshotsResponse{}
+	for _, snapshot := range snapshots {
+		res.Snapshots = append(res.Snapshots, apiWorkspaceSnapshot(snapshot))
+	}
+
+	return res, nil
+}
+
+// RestoreWorkspaceSnapshot replaces the volumes of a paused workspace with the ones in a snapshot
+func (s *WorkspaceServer) RestoreWorkspaceSnapshot(ctx context.Context, req *api.RestoreWorkspaceSnapshotRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleEditor)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.RestoreWorkspaceSnapshot(req.Namespace, req.Uid, req.SnapshotUid)
+
+	return &empty.Empty{}, err
+}
+
+func apiWorkspaceEvent(event *v1.WorkspaceEvent) *api.WorkspaceEvent {
+	res := &api.WorkspaceEvent{
+		Action:           string(event.Action),
+		OldPhase:         string(event.OldPhase),
+		NewPhase:         string(event.NewPhase),
+		ParameterChanges: converter.ParameterChangesToAPI(event.ParameterChanges),
+		CreatedAt:        event.CreatedAt.UTC().Format(time.RFC3339),
+	}
+
+	if event.Actor != nil {
+		res.Actor = *event.Actor
+	}
+
+	if event.ErrorMessage != nil {
+		res.ErrorMessage = *event.ErrorMessage
+	}
+
+	return res
+}
+
+// ListWorkspaceEvents returns the history of a workspace, newest first
+func (s *WorkspaceServer) ListWorkspaceEvents(ctx context.Context, req *api.ListWorkspaceEventsRequest) (*api.ListWorkspaceEventsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "get", req.Uid, v1.WorkspaceRoleViewer)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	paginator := pagination.NewWithContinue(req.Page, req.PageSize, req.ContinueToken)
+	events, err := client.ListWorkspaceEvents(req.Namespace, req.Uid, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListWorkspaceEventsResponse{
+		Page:              int32(paginator.Page),
+		NextContinueToken: paginator.NextContinue,
+	}
+	for _, event := range events {
+		res.Events = append(res.Events, apiWorkspaceEvent(event))
+	}
+
+	return res, nil
+}
+
 // ListWorkspacesField returns a list of all the distinct values of a field from Workspaces
 func (s *WorkspaceServer) ListWorkspacesField(ctx context.Context, req *api.ListWorkspacesFieldRequest) (*api.ListWorkspacesFieldResponse, error) {
 	client := getClient(ctx)
@@ -503,3 +1014,68 @@ func (s *WorkspaceServer) ListWorkspacesField(ctx context.Context, req *api.List
 		Values: values,
 	}, nil
 }
+
+func apiWorkspaceCollaborator(collaborator *v1.WorkspaceCollaborator) *api.WorkspaceCollaborator {
+	return &api.WorkspaceCollaborator{
+		Username:  collaborator.Username,
+		Role:      string(collaborator.Role),
+		CreatedAt: collaborator.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// ListWorkspaceCollaborators returns the owner of a workspace and the users it is shared with
+func (s *WorkspaceServer) ListWorkspaceCollaborators(ctx context.Context, req *api.ListWorkspaceCollaboratorsRequest) (*api.ListWorkspaceCollaboratorsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "get", req.Uid, v1.WorkspaceRoleViewer)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	owner, collaborators, err := client.ListWorkspaceCollaborators(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.ListWorkspaceCollaboratorsResponse{}
+	if owner != nil {
+		res.Owner = *owner
+	}
+	for _, collaborator := range collaborators {
+		res.Collaborators = append(res.Collaborators, apiWorkspaceCollaborator(collaborator))
+	}
+
+	return res, nil
+}
+
+// AddWorkspaceCollaborator shares a workspace with a user as an editor or viewer
+func (s *WorkspaceServer) AddWorkspaceCollaborator(ctx context.Context, req *api.AddWorkspaceCollaboratorRequest) (*api.WorkspaceCollaborator, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleOwner)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Collaborator == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Collaborator is required.")
+	}
+
+	collaborator, err := client.AddWorkspaceCollaborator(req.Namespace, req.Uid, req.Collaborator.Username, v1.WorkspaceRole(req.Collaborator.Role))
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkspaceCollaborator(collaborator), nil
+}
+
+// RemoveWorkspaceCollaborator stops sharing a workspace with a user
+func (s *WorkspaceServer) RemoveWorkspaceCollaborator(ctx context.Context, req *api.RemoveWorkspaceCollaboratorRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := isWorkspaceAuthorized(client, req.Namespace, "update", req.Uid, v1.WorkspaceRoleOwner)
+	if err != nil || !allowed {
+		return &empty.Empty{}, err
+	}
+
+	err = client.RemoveWorkspaceCollaborator(req.Namespace, req.Uid, req.Username)
+
+	return &empty.Empty{}, err
+}
@@ -150,7 +150,7 @@ func (s *WorkspaceTemplateServer) ListWorkspaceTemplates(ctx context.Context, re
 	}
 
 	resourceRequest := &request.Request{
-		Pagination: pagination.New(req.Page, req.PageSize),
+		Pagination: pagination.NewWithContinue(req.Page, req.PageSize, req.ContinueToken),
 		Filter: v1.WorkspaceTemplateFilter{
 			Labels: labelFilter,
 			UID:    req.Uid,
@@ -180,6 +180,7 @@ func (s *WorkspaceTemplateServer) ListWorkspaceTemplates(ctx context.Context, re
 		Page:               int32(paginator.Page),
 		Pages:              paginator.CalculatePages(count),
 		TotalCount:         int32(count),
+		NextContinueToken:  paginator.NextContinue,
 	}, nil
 }
 
@@ -248,3 +249,35 @@ func (s *WorkspaceTemplateServer) ListWorkspaceTemplatesField(ctx context.Contex
 		Values: values,
 	}, nil
 }
+
+// SetLatestWorkspaceTemplateVersion makes a previous version of a workspace template the latest one
+func (s *WorkspaceTemplateServer) SetLatestWorkspaceTemplateVersion(ctx context.Context, req *api.SetLatestWorkspaceTemplateVersionRequest) (*api.WorkspaceTemplate, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflowtemplates", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	workspaceTemplate, err := client.SetLatestWorkspaceTemplateVersion(req.Namespace, req.Uid, req.Version, req.Clone)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkspaceTemplate(workspaceTemplate), nil
+}
+
+// DiffWorkspaceTemplateVersions returns the changes between two versions of a workspace template
+func (s *WorkspaceTemplateServer) DiffWorkspaceTemplateVersions(ctx context.Context, req *api.DiffWorkspaceTemplateVersionsRequest) (*api.TemplateVersionDiff, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflowtemplates", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	diff, err := client.DiffWorkspaceTemplateVersions(req.Namespace, req.Uid, req.FromVersion, req.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.TemplateVersionDiffToAPI(diff), nil
+}